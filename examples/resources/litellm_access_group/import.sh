@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_access_group.example beta-models
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "litellm_access_group" "example" {
+  access_group = "beta-models"
+  model_ids    = [litellm_model.gpt_4o_eu.id, litellm_model.gpt_4o_us.id]
+}
+
+resource "litellm_key" "beta_tester" {
+  key_alias = "beta-tester"
+  models    = [litellm_access_group.example.access_group]
+}
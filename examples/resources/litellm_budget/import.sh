@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_budget.gold gold
\ No newline at end of file
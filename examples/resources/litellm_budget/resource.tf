@@ -0,0 +1,14 @@
+resource "litellm_budget" "gold" {
+  budget_id       = "gold"
+  max_budget      = 500
+  soft_budget     = 400
+  budget_duration = "30d"
+  tpm_limit       = 200000
+  rpm_limit       = 200
+
+  model_max_budget {
+    model           = litellm_model.example.model_name
+    max_budget      = 100
+    budget_duration = "1d"
+  }
+}
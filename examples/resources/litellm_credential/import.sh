@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_credential.example azure-prod
\ No newline at end of file
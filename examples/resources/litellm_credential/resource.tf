@@ -0,0 +1,26 @@
+resource "litellm_credential" "example" {
+  credential_name = "azure-prod"
+
+  credential_values = {
+    api_key     = var.azure_api_key
+    api_base    = "https://example.openai.azure.com"
+    api_version = "2024-08-01-preview"
+  }
+
+  credential_info = {
+    custom_llm_provider = "azure"
+  }
+}
+
+resource "litellm_model" "gpt_4o" {
+  model_name              = "gpt-4o"
+  litellm_credential_name = litellm_credential.example.credential_name
+
+  litellm_params {
+    model = "azure/gpt-4o"
+  }
+
+  model_info {
+    id = "azure-gpt-4o"
+  }
+}
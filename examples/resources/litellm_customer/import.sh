@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_customer.example customer-acme
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "litellm_customer" "example" {
+  user_id              = "customer-acme"
+  alias                = "ACME Corp"
+  budget_id            = litellm_budget.gold.id
+  allowed_model_region = "eu"
+  default_model        = "gpt-4o-mini"
+}
@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_guardrail.example 3f4e6f8a-1b2c-4d5e-8f90-123456789abc
\ No newline at end of file
@@ -0,0 +1,17 @@
+resource "litellm_guardrail" "example" {
+  guardrail_name = "pii-masking"
+  guardrail      = "presidio"
+  mode           = "pre_call"
+  default_on     = true
+
+  litellm_params {
+    presidio_analyzer_api_base   = "http://presidio-analyzer:3000"
+    presidio_anonymizer_api_base = "http://presidio-anonymizer:3000"
+    output_parse_pii             = true
+
+    pii_entities_config = {
+      CREDIT_CARD   = "BLOCK"
+      EMAIL_ADDRESS = "MASK"
+    }
+  }
+}
@@ -0,0 +1,6 @@
+#!/bin/sh
+# Import by hashed token
+terraform import litellm_key.example 88dc28d0f030c55ed4ab77ed8faf098196cb1c05df778539800c9f1243fe6b4b
+
+# Import by key_alias, only when a single key uses that alias
+terraform import litellm_key.example ci-pipeline
\ No newline at end of file
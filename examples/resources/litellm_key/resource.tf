@@ -0,0 +1,23 @@
+resource "litellm_key" "example" {
+  key_alias       = "ci-pipeline"
+  models          = ["gpt-4o", "gpt-4o-mini"]
+  max_budget      = 100
+  budget_duration = "30d"
+  tpm_limit       = 100000
+  rpm_limit       = 100
+  team_id         = "platform-team"
+  duration        = "90d"
+
+  metadata = {
+    owner = "platform"
+  }
+
+  aliases = {
+    "gpt-4" = "gpt-4o"
+  }
+}
+
+output "example_key" {
+  value     = litellm_key.example.key
+  sensitive = true
+}
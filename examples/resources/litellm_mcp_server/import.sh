@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_mcp_server.example 7c9e6679-7425-40de-944b-e07fc1f90ae7
\ No newline at end of file
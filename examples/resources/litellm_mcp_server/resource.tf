@@ -0,0 +1,12 @@
+resource "litellm_mcp_server" "example" {
+  server_name = "github"
+  description = "GitHub issues and pull requests"
+  transport   = "http"
+  url         = "https://api.githubcopilot.com/mcp"
+
+  auth_type  = "bearer_token"
+  auth_value = var.github_token
+
+  allowed_tools     = ["list_issues", "get_issue", "create_pull_request"]
+  mcp_access_groups = ["engineering"]
+}
@@ -1,2 +1,6 @@
 #!/bin/sh
+# Import by model ID
+terraform import litellm_model.example_model unique-model-id
+
+# Import by model_name, only when a single deployment uses that name
 terraform import litellm_model.example_model existing-model-name
\ No newline at end of file
@@ -1,16 +1,25 @@
 resource "litellm_model" "example" {
   model_name = "example-model"
 
-  litellm_params = {
+  litellm_params {
     custom_llm_provider = "openai"
     model               = "gpt-3.5-turbo"
-    api_key             = "your_underlying_model_api_key"
+    api_key             = var.openai_api_key
     api_base            = "https://api.openai.com/v1"
+    rpm                 = 100
+    timeout             = 30
+
+    extra_params = jsonencode({
+      organization = "org-example"
+    })
   }
 
-  model_info = {
-    id         = "unique-model-id"
-    base_model = "gpt-3.5-turbo"
-    tier       = "paid"
+  model_info {
+    id                        = "unique-model-id"
+    base_model                = "gpt-3.5-turbo"
+    tier                      = "paid"
+    mode                      = "chat"
+    max_tokens                = 16385
+    supports_function_calling = true
   }
 }
@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_organization.example existing-organization-id
\ No newline at end of file
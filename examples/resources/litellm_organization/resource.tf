@@ -0,0 +1,12 @@
+resource "litellm_organization" "example" {
+  organization_alias = "acme"
+  models             = ["gpt-4o", "gpt-4o-mini"]
+  max_budget         = 1000
+  budget_duration    = "30d"
+  tpm_limit          = 1000000
+  rpm_limit          = 1000
+
+  metadata = {
+    contract = "enterprise"
+  }
+}
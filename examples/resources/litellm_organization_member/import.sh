@@ -0,0 +1,6 @@
+#!/bin/sh
+# Import by organization_id/user_id:<user_id>
+terraform import litellm_organization_member.example existing-organization-id/user_id:existing-user-id
+
+# Import by organization_id/user_email:<user_email>
+terraform import litellm_organization_member.example existing-organization-id/user_email:jane@example.com
\ No newline at end of file
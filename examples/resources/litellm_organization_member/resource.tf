@@ -0,0 +1,6 @@
+resource "litellm_organization_member" "example" {
+  organization_id            = litellm_organization.example.id
+  user_id                    = litellm_user.example.id
+  role                       = "org_admin"
+  max_budget_in_organization = 100
+}
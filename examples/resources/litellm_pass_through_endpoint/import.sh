@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_pass_through_endpoint.example /v1/rerank
\ No newline at end of file
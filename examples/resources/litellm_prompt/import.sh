@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_prompt.summarizer summarizer
\ No newline at end of file
@@ -0,0 +1,21 @@
+resource "litellm_prompt" "support_agent" {
+  prompt_id     = "support-agent"
+  template_file = "${path.module}/prompts/support-agent.prompt"
+
+  litellm_params = {
+    model = "gpt-4o"
+  }
+}
+
+resource "litellm_prompt" "summarizer" {
+  prompt_id = "summarizer"
+  template  = <<-EOT
+    ---
+    model: gpt-4o-mini
+    input:
+      schema:
+        text: string
+    ---
+    Summarize the following text in three bullet points: {{text}}
+  EOT
+}
@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_tag.example team-search
\ No newline at end of file
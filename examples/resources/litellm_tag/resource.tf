@@ -0,0 +1,8 @@
+resource "litellm_tag" "example" {
+  name        = "team-search"
+  description = "Routes the search team to its dedicated deployments"
+  models      = [litellm_model.gpt_4o_eu.id, litellm_model.gpt_4o_us.id]
+
+  max_budget      = 200
+  budget_duration = "30d"
+}
@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_team.example existing-team-id
\ No newline at end of file
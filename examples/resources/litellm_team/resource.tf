@@ -0,0 +1,13 @@
+resource "litellm_team" "example" {
+  team_alias      = "platform"
+  models          = ["gpt-4o", "gpt-4o-mini"]
+  max_budget      = 500
+  budget_duration = "30d"
+  tpm_limit       = 500000
+  rpm_limit       = 500
+  organization_id = "my-organization-id"
+
+  metadata = {
+    cost_center = "1234"
+  }
+}
@@ -0,0 +1,6 @@
+#!/bin/sh
+# Import by team_id/user_id:<user_id>
+terraform import litellm_team_member.john existing-team-id/user_id:john
+
+# Import by team_id/user_email:<user_email>
+terraform import litellm_team_member.jane existing-team-id/user_email:jane@example.com
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "litellm_team_member" "jane" {
+  team_id            = litellm_team.example.id
+  user_email         = "jane@example.com"
+  role               = "admin"
+  max_budget_in_team = 50
+}
+
+resource "litellm_team_member" "john" {
+  team_id = litellm_team.example.id
+  user_id = "john"
+}
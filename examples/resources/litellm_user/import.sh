@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_user.example existing-user-id
\ No newline at end of file
@@ -0,0 +1,13 @@
+resource "litellm_user" "example" {
+  user_email      = "jane@example.com"
+  user_role       = "internal_user"
+  models          = ["gpt-4o", "gpt-4o-mini"]
+  max_budget      = 25
+  budget_duration = "30d"
+  teams           = [litellm_team.example.id]
+  auto_create_key = false
+
+  metadata = {
+    department = "research"
+  }
+}
@@ -0,0 +1,2 @@
+#!/bin/sh
+terraform import litellm_vector_store.example T37J8R4WTM
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "litellm_vector_store" "example" {
+  vector_store_id          = "T37J8R4WTM"
+  custom_llm_provider      = "bedrock"
+  vector_store_name        = "product-docs"
+  vector_store_description = "Knowledge base of the product documentation"
+  litellm_credential_name  = litellm_credential.aws.credential_name
+
+  vector_store_metadata = {
+    owner = "search-team"
+  }
+}
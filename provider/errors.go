@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+// diagFromErr turns err into diagnostics, APIErrors carry the request, the status and the offending attribute
+func diagFromErr(err error, resourceSchema map[string]*schema.Schema) diag.Diagnostics {
+	var apiErr *litellmapi.APIError
+	if !errors.As(err, &apiErr) {
+		return diag.FromErr(err)
+	}
+
+	detail := apiErr.Message
+	if apiErr.Type != "" {
+		detail = fmt.Sprintf("%s\nType: %s", detail, apiErr.Type)
+	}
+	if apiErr.Code != "" {
+		detail = fmt.Sprintf("%s\nCode: %s", detail, apiErr.Code)
+	}
+	if apiErr.Param != "" {
+		detail = fmt.Sprintf("%s\nAttribute: %s", detail, apiErr.Param)
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("LiteLLM API request %s %s failed with status code %d", apiErr.Method, apiErr.Path, apiErr.StatusCode),
+		Detail:        detail,
+		AttributePath: paramToAttributePath(apiErr.Param, resourceSchema),
+	}}
+}
+
+// paramToAttributePath maps a dotted LiteLLM param such as litellm_params.api_base to the attribute it comes from
+func paramToAttributePath(param string, resourceSchema map[string]*schema.Schema) cty.Path {
+	if param == "" {
+		return nil
+	}
+
+	parts := strings.Split(param, ".")
+	attribute, ok := resourceSchema[parts[0]]
+	if !ok {
+		return nil
+	}
+
+	path := cty.GetAttrPath(parts[0])
+	if len(parts) == 1 {
+		return path
+	}
+
+	switch elem := attribute.Elem.(type) {
+	case *schema.Resource:
+		// Single blocks such as litellm_params, params without an attribute of their own point to the block
+		if attribute.MaxItems == 1 {
+			path = path.IndexInt(0)
+			if _, ok := elem.Schema[parts[1]]; ok {
+				path = path.GetAttr(parts[1])
+			}
+		}
+	default:
+		if attribute.Type == schema.TypeMap {
+			path = path.IndexString(parts[1])
+		}
+	}
+	return path
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func TestDiagFromErr(t *testing.T) {
+	err := &litellmapi.APIError{
+		Method:     "POST",
+		Path:       "/model/new",
+		StatusCode: http.StatusBadRequest,
+		Message:    "Invalid model name passed in model=gpt-5",
+		Type:       "invalid_request_error",
+		Param:      "litellm_params.model",
+		Code:       "400",
+	}
+
+	diags := diagFromErr(err, resourceModel().Schema)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "LiteLLM API request POST /model/new failed with status code 400", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "Invalid model name passed in model=gpt-5")
+	assert.Contains(t, diags[0].Detail, "Attribute: litellm_params.model")
+	assert.Equal(t, cty.GetAttrPath("litellm_params").IndexInt(0).GetAttr("model"), diags[0].AttributePath)
+
+	err.Param = "litellm_params.region_name"
+	assert.Equal(t, cty.GetAttrPath("litellm_params").IndexInt(0), diagFromErr(err, resourceModel().Schema)[0].AttributePath)
+
+	err.Param = "metadata.owner"
+	assert.Equal(t, cty.GetAttrPath("metadata").IndexString("owner"), diagFromErr(err, resourceKey().Schema)[0].AttributePath)
+
+	err.Param = "model_name"
+	assert.Equal(t, cty.GetAttrPath("model_name"), diagFromErr(err, resourceModel().Schema)[0].AttributePath)
+
+	err.Param = "unknown_param"
+	assert.Nil(t, diagFromErr(err, resourceModel().Schema)[0].AttributePath)
+
+	diags = diagFromErr(errors.New("connection refused"), resourceModel().Schema)
+	assert.Equal(t, "connection refused", diags[0].Summary)
+}
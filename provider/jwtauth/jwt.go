@@ -1,6 +1,7 @@
 package jwtauth
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -12,11 +13,17 @@ import (
 	"strings"
 )
 
+// HTTPDoer sends the token request, http.DefaultClient is used when JwtAuth.HTTPClient is nil
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 type JwtAuth struct {
 	TokenEndpoint  string
 	RequestHeader  map[string]string
 	RequestPayload map[string]string
 	TokenAttribute string
+	HTTPClient     HTTPDoer
 }
 
 func IsApiTokenSet(apiToken string) bool {
@@ -33,6 +40,10 @@ func BasicAuth(username string, password string) string {
 }
 
 func GetApiTokenFromJwt(jwtAuth *JwtAuth) (string, error) {
+	return GetApiTokenFromJwtWithContext(context.Background(), jwtAuth)
+}
+
+func GetApiTokenFromJwtWithContext(ctx context.Context, jwtAuth *JwtAuth) (string, error) {
 	// jsonBody := map[string]string{"grant_type": "client_credentials", "scope": jwtCredentials.JwtScope, "client_id": jwtCredentials.JwtClientId, "client_secret": jwtCredentials.JwtClientSecret}
 	contentType := jwtAuth.RequestHeader["Content-Type"]
 	if contentType == "" {
@@ -53,14 +64,19 @@ func GetApiTokenFromJwt(jwtAuth *JwtAuth) (string, error) {
 		}
 		requestPayload = strings.NewReader(data.Encode())
 	}
-	r, err := http.NewRequest(http.MethodPost, jwtAuth.TokenEndpoint, requestPayload)
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, jwtAuth.TokenEndpoint, requestPayload)
 	if err != nil {
 		return "", err
 	}
 
 	r.Header.Add("Content-Type", contentType)
 
-	response, err := http.DefaultClient.Do(r)
+	var httpClient HTTPDoer = http.DefaultClient
+	if jwtAuth.HTTPClient != nil {
+		httpClient = jwtAuth.HTTPClient
+	}
+
+	response, err := httpClient.Do(r)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,68 @@
+package litellmapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Budget is a named budget tier that can be attached to customers and memberships
+type Budget struct {
+	BudgetID       string                 `json:"budget_id,omitempty"`
+	MaxBudget      *float64               `json:"max_budget,omitempty"`
+	SoftBudget     *float64               `json:"soft_budget,omitempty"`
+	BudgetDuration string                 `json:"budget_duration,omitempty"`
+	TpmLimit       *int64                 `json:"tpm_limit,omitempty"`
+	RpmLimit       *int64                 `json:"rpm_limit,omitempty"`
+	ModelMaxBudget map[string]ModelBudget `json:"model_max_budget,omitempty"`
+}
+
+// ModelBudget restricts the spend and rate of a single model within a budget
+type ModelBudget struct {
+	MaxBudget      *float64 `json:"max_budget,omitempty"`
+	BudgetDuration string   `json:"budget_duration,omitempty"`
+	TpmLimit       *int64   `json:"tpm_limit,omitempty"`
+	RpmLimit       *int64   `json:"rpm_limit,omitempty"`
+}
+
+func (c *LitellmClient) CreateBudget(ctx context.Context, budget *Budget) (*Budget, error) {
+	var created Budget
+	if err := c.doJSON(ctx, http.MethodPost, "/budget/new", nil, budget, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *LitellmClient) GetBudget(ctx context.Context, budgetID string) (*Budget, error) {
+	var budgets []Budget
+	request := map[string][]string{"budgets": {budgetID}}
+	if err := c.doJSON(ctx, http.MethodPost, "/budget/info", nil, request, &budgets); err != nil {
+		return nil, err
+	}
+
+	for _, budget := range budgets {
+		if budget.BudgetID == budgetID {
+			return &budget, nil
+		}
+	}
+
+	return nil, &APIError{
+		Method:     http.MethodPost,
+		Path:       "/budget/info",
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("budget %s not found", budgetID),
+	}
+}
+
+// UpdateBudget updates the budget identified by budget.BudgetID, the cleared fields are sent as null so that the proxy removes them
+func (c *LitellmClient) UpdateBudget(ctx context.Context, budget *Budget, cleared ...string) error {
+	request, err := withNulls(budget, cleared)
+	if err != nil {
+		return err
+	}
+	return c.doJSON(ctx, http.MethodPost, "/budget/update", nil, request, nil)
+}
+
+func (c *LitellmClient) DeleteBudget(ctx context.Context, budgetID string) error {
+	return c.doJSON(ctx, http.MethodPost, "/budget/delete", nil, map[string]string{"id": budgetID}, nil)
+}
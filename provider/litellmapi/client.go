@@ -0,0 +1,241 @@
+// Package litellmapi is a typed client for the LiteLLM proxy management API, shared by every resource of the provider.
+package litellmapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultRequestTimeout = 60
+	DefaultMaxRetries     = 3
+	DefaultRetryWaitMin   = 1 * time.Second
+	DefaultRetryWaitMax   = 30 * time.Second
+)
+
+// LitellmClient sends authenticated requests to the LiteLLM proxy
+type LitellmClient struct {
+	ApiToken          string            `tfsdk:"api_token"`
+	ApiBaseURL        string            `tfsdk:"api_base_url"`
+	JwtTokenEndpoint  string            `tfsdk:"jwt_token_endpoint"`
+	JwtRequestHeader  map[string]string `tfsdk:"jwt_request_header"`
+	JwtRequestPayload map[string]string `tfsdk:"jwt_request_payload"`
+	JwtTokenAttribute string            `tfsdk:"jwt_token_attribute"`
+	MaxRetries        int               `tfsdk:"max_retries"`
+
+	HTTPClient   *http.Client
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+}
+
+// NewLitellmClient returns a client whose requests time out after requestTimeout and are retried up to maxRetries times
+func NewLitellmClient(apiBaseURL string, requestTimeout time.Duration, maxRetries int) *LitellmClient {
+	return &LitellmClient{
+		ApiBaseURL:   strings.TrimSuffix(apiBaseURL, "/"),
+		MaxRetries:   maxRetries,
+		HTTPClient:   &http.Client{Timeout: requestTimeout},
+		RetryWaitMin: DefaultRetryWaitMin,
+		RetryWaitMax: DefaultRetryWaitMax,
+	}
+}
+
+func (c *LitellmClient) NewRequest(ctx context.Context, method string, url string, body io.Reader) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Content-Type", "application/json")
+	request.Header.Add("Authorization", "Bearer "+c.ApiToken)
+	return request, nil
+}
+
+// Do sends the request, retrying with exponential backoff on connection errors, 429 and 5xx responses.
+// Requests creating an object are only retried when the proxy cannot have processed them, see shouldRetry.
+// The request body is replayed on each attempt, so it must come from NewRequest or have GetBody set.
+func (c *LitellmClient) Do(req *http.Request) (*http.Response, error) {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := httpClient.Do(req)
+		if attempt >= c.MaxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := c.backoff(attempt, resp)
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// doJSON sends requestBody as JSON to path on the proxy and decodes the response into responseBody.
+// Both bodies are optional, non 2xx responses are returned as *APIError, with the secrets of the request redacted.
+func (c *LitellmClient) doJSON(ctx context.Context, method string, path string, query url.Values, requestBody interface{}, responseBody interface{}) error {
+	requestURL := c.ApiBaseURL + path
+	if len(query) > 0 {
+		requestURL = requestURL + "?" + query.Encode()
+	}
+
+	var body io.Reader
+	var secrets []string
+	if requestBody != nil {
+		jsonData, err := json.Marshal(requestBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(jsonData)
+		secrets = requestSecrets(jsonData)
+	}
+
+	req, err := c.NewRequest(ctx, method, requestURL, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := c.checkResponse(resp, secrets); err != nil {
+		return err
+	}
+
+	if responseBody == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(responseBody); err != nil && err != io.EOF {
+		return fmt.Errorf("decoding response of %s %s: %w", method, path, err)
+	}
+	return nil
+}
+
+// withNulls returns the JSON object of v with the given fields set to null. The update endpoints of the proxy
+// leave the fields missing from the request untouched, so clearing a field takes an explicit null.
+func withNulls(v interface{}, nulls []string) (interface{}, error) {
+	if len(nulls) == 0 {
+		return v, nil
+	}
+
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	object := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &object); err != nil {
+		return nil, err
+	}
+	for _, field := range nulls {
+		object[field] = nil
+	}
+	return object, nil
+}
+
+// shouldRetry reports whether a failed attempt is worth repeating. Idempotent requests are retried on any
+// connection error, 429 and 5xx. Other requests, such as /key/generate, may have been committed by the proxy
+// before the response failed, and a retry would create a duplicate: they are only retried on 429, or when
+// the connection could not be established so nothing was sent.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	ctx := req.Context()
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return false
+		}
+		var opErr *net.OpError
+		return isIdempotent(req) || (errors.As(err, &opErr) && opErr.Op == "dial")
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return isIdempotent(req) && resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented
+}
+
+// idempotentActions are the last path segments of the POST endpoints that can safely be sent twice
+var idempotentActions = map[string]bool{
+	"info":          true,
+	"update":        true,
+	"delete":        true,
+	"member_update": true,
+	"member_delete": true,
+	"block":         true,
+	"unblock":       true,
+}
+
+// isIdempotent reports whether sending the request twice has the same effect as sending it once
+func isIdempotent(req *http.Request) bool {
+	if req.Method != http.MethodPost {
+		return true
+	}
+	path := strings.TrimSuffix(req.URL.Path, "/")
+	return idempotentActions[path[strings.LastIndex(path, "/")+1:]]
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After header sent by the
+// proxy wins, otherwise the wait doubles on each attempt with jitter, capped at RetryWaitMax.
+func (c *LitellmClient) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, c.RetryWaitMax)
+		}
+	}
+
+	wait := c.RetryWaitMin << attempt
+	if wait <= 0 || wait > c.RetryWaitMax {
+		wait = c.RetryWaitMax
+	}
+	if wait <= 1 {
+		return wait
+	}
+
+	half := wait / 2
+	return half + time.Duration(rand.Int64N(int64(wait-half)))
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,173 @@
+package litellmapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(baseURL string, maxRetries int) *LitellmClient {
+	client := NewLitellmClient(baseURL, time.Second, maxRetries)
+	client.ApiToken = "test-token"
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = 5 * time.Millisecond
+	return client
+}
+
+func TestClientRetriesServerErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"id":"model"}`, string(body))
+
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, 3)
+	req, err := client.NewRequest(context.Background(), "POST", server.URL+"/model/update", bytes.NewBufferString(`{"id":"model"}`))
+	assert.NoError(t, err)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClientDoesNotRetryCreatesOnServerErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, 3)
+	req, err := client.NewRequest(context.Background(), "POST", server.URL+"/key/generate", bytes.NewBufferString(`{"key_alias":"ci-key"}`))
+	assert.NoError(t, err)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestClientRetriesCreatesOnTooManyRequests(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, 3)
+	req, err := client.NewRequest(context.Background(), "POST", server.URL+"/user/new", bytes.NewBufferString(`{}`))
+	assert.NoError(t, err)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClientStopsAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, 2)
+	req, err := client.NewRequest(context.Background(), "GET", server.URL, nil)
+	assert.NoError(t, err)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClientDoesNotRetryClientErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, 3)
+	req, err := client.NewRequest(context.Background(), "GET", server.URL, nil)
+	assert.NoError(t, err)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestClientTimesOutHungRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, 0)
+	client.HTTPClient.Timeout = 50 * time.Millisecond
+	req, err := client.NewRequest(context.Background(), "GET", server.URL, nil)
+	assert.NoError(t, err)
+
+	_, err = client.Do(req)
+	assert.Error(t, err)
+}
+
+func TestClientHonorsContextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, 5)
+	client.RetryWaitMin = time.Second
+	client.RetryWaitMax = time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := client.NewRequest(ctx, "GET", server.URL, nil)
+	assert.NoError(t, err)
+
+	_, err = client.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClientBackoff(t *testing.T) {
+	client := NewLitellmClient("http://localhost", time.Second, 3)
+
+	resp := &http.Response{Header: http.Header{"Retry-After": {"7"}}}
+	assert.Equal(t, 7*time.Second, client.backoff(0, resp))
+
+	resp = &http.Response{Header: http.Header{"Retry-After": {"3600"}}}
+	assert.Equal(t, DefaultRetryWaitMax, client.backoff(0, resp))
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := client.backoff(attempt, nil)
+		expected := min(DefaultRetryWaitMin<<attempt, DefaultRetryWaitMax)
+		assert.GreaterOrEqual(t, wait, expected/2)
+		assert.LessOrEqual(t, wait, expected)
+	}
+}
@@ -0,0 +1,39 @@
+package litellmapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Credential is a set of provider credentials stored by the proxy and shared by several deployments
+type Credential struct {
+	CredentialName   string                 `json:"credential_name"`
+	CredentialValues map[string]interface{} `json:"credential_values,omitempty"`
+	CredentialInfo   map[string]interface{} `json:"credential_info,omitempty"`
+}
+
+func credentialPath(name string) string {
+	return "/credentials/" + url.PathEscape(name)
+}
+
+func (c *LitellmClient) CreateCredential(ctx context.Context, credential *Credential) error {
+	return c.doJSON(ctx, http.MethodPost, "/credentials", nil, credential, nil)
+}
+
+// GetCredential returns the credential, the proxy does not return the secret values
+func (c *LitellmClient) GetCredential(ctx context.Context, name string) (*Credential, error) {
+	var credential Credential
+	if err := c.doJSON(ctx, http.MethodGet, "/credentials/by_name/"+url.PathEscape(name), nil, nil, &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+func (c *LitellmClient) UpdateCredential(ctx context.Context, credential *Credential) error {
+	return c.doJSON(ctx, http.MethodPatch, credentialPath(credential.CredentialName), nil, credential, nil)
+}
+
+func (c *LitellmClient) DeleteCredential(ctx context.Context, name string) error {
+	return c.doJSON(ctx, http.MethodDelete, credentialPath(name), nil, nil, nil)
+}
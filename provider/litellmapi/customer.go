@@ -0,0 +1,63 @@
+package litellmapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Customer is an end user of the applications built on the proxy, tracked for budgets and billing
+type Customer struct {
+	UserID             string   `json:"user_id"`
+	Alias              string   `json:"alias,omitempty"`
+	Blocked            bool     `json:"blocked"`
+	MaxBudget          *float64 `json:"max_budget,omitempty"`
+	BudgetID           string   `json:"budget_id,omitempty"`
+	AllowedModelRegion string   `json:"allowed_model_region,omitempty"`
+	DefaultModel       string   `json:"default_model,omitempty"`
+}
+
+type customerInfoResponse struct {
+	Customer
+	LitellmBudgetTable *budgetTable `json:"litellm_budget_table"`
+}
+
+func (c *LitellmClient) CreateCustomer(ctx context.Context, customer *Customer) error {
+	return c.doJSON(ctx, http.MethodPost, "/customer/new", nil, customer, nil)
+}
+
+// GetCustomer returns the customer, MaxBudget is read from the budget attached to it
+func (c *LitellmClient) GetCustomer(ctx context.Context, userID string) (*Customer, error) {
+	var response customerInfoResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/customer/info", url.Values{"end_user_id": {userID}}, nil, &response); err != nil {
+		return nil, err
+	}
+
+	customer := response.Customer
+	if response.LitellmBudgetTable != nil {
+		customer.MaxBudget = response.LitellmBudgetTable.MaxBudget
+	}
+	return &customer, nil
+}
+
+// UpdateCustomer updates the customer identified by customer.UserID, the cleared fields are sent as null so that the proxy removes them
+func (c *LitellmClient) UpdateCustomer(ctx context.Context, customer *Customer, cleared ...string) error {
+	request, err := withNulls(customer, cleared)
+	if err != nil {
+		return err
+	}
+	return c.doJSON(ctx, http.MethodPost, "/customer/update", nil, request, nil)
+}
+
+// SetCustomerBlocked blocks or unblocks the requests of the customer
+func (c *LitellmClient) SetCustomerBlocked(ctx context.Context, userID string, blocked bool) error {
+	path := "/customer/unblock"
+	if blocked {
+		path = "/customer/block"
+	}
+	return c.doJSON(ctx, http.MethodPost, path, nil, map[string][]string{"user_ids": {userID}}, nil)
+}
+
+func (c *LitellmClient) DeleteCustomer(ctx context.Context, userID string) error {
+	return c.doJSON(ctx, http.MethodPost, "/customer/delete", nil, map[string][]string{"user_ids": {userID}}, nil)
+}
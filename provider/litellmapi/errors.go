@@ -0,0 +1,212 @@
+package litellmapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const redactedValue = "<redacted>"
+
+// secretPattern matches the API keys and bearer tokens LiteLLM and upstream providers echo back in error messages
+var secretPattern = regexp.MustCompile(`(?i)(bearer\s+)[^\s"',]+|\bsk-[A-Za-z0-9_\-*]{4,}`)
+
+// secretKeyPattern matches the request attributes holding secrets, e.g. api_key, aws_secret_access_key or auth_value
+var secretKeyPattern = regexp.MustCompile(`(?i)(key|secret|token|password|credentials|auth_value)$`)
+
+// secretContainers are the request attributes whose values are all secrets
+var secretContainers = map[string]bool{"credential_values": true, "headers": true, "env": true}
+
+// APIError is a non successful response returned by the LiteLLM API
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Message    string
+	Type       string
+	Param      string
+	Code       string
+
+	// notFound marks errors reporting a missing resource with another status code than 404
+	notFound bool
+}
+
+func (e *APIError) Error() string {
+	message := fmt.Sprintf("%s %s returned status code %d", e.Method, e.Path, e.StatusCode)
+	if e.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, e.Message)
+	}
+	if e.Param != "" {
+		message = fmt.Sprintf("%s (param: %s)", message, e.Param)
+	}
+	return message
+}
+
+// IsNotFound reports whether err is an APIError for a resource the proxy does not know about
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.notFound)
+}
+
+// CheckResponse returns an *APIError built from the response body when the status code is not 2xx
+func (c *LitellmClient) CheckResponse(resp *http.Response) error {
+	return c.checkResponse(resp, nil)
+}
+
+// checkResponse is CheckResponse redacting secrets, the secret values of the request, from the error message
+// in case the proxy echoes the request back
+func (c *LitellmClient) checkResponse(resp *http.Response, secrets []string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Path = resp.Request.URL.Path
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	parseErrorBody(apiErr, body)
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	apiErr.Message = c.redact(apiErr.Message, secrets)
+
+	return apiErr
+}
+
+// parseErrorBody fills apiErr from the {"error": {...}} and FastAPI {"detail": ...} shapes LiteLLM answers with
+func parseErrorBody(apiErr *APIError, body []byte) {
+	var payload struct {
+		Error  json.RawMessage `json:"error"`
+		Detail json.RawMessage `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		apiErr.Message = strings.TrimSpace(string(body))
+		return
+	}
+
+	if len(payload.Error) > 0 {
+		var litellmError struct {
+			Message string      `json:"message"`
+			Type    string      `json:"type"`
+			Param   interface{} `json:"param"`
+			Code    interface{} `json:"code"`
+		}
+		if err := json.Unmarshal(payload.Error, &litellmError); err == nil {
+			apiErr.Message = litellmError.Message
+			apiErr.Type = litellmError.Type
+			apiErr.Param = stringOrEmpty(litellmError.Param)
+			apiErr.Code = stringOrEmpty(litellmError.Code)
+			return
+		}
+		apiErr.Message = stringOrEmpty(unmarshalAny(payload.Error))
+		return
+	}
+
+	switch detail := unmarshalAny(payload.Detail).(type) {
+	case string:
+		apiErr.Message = detail
+	case map[string]interface{}:
+		if message, ok := detail["error"]; ok {
+			apiErr.Message = stringOrEmpty(message)
+		} else {
+			apiErr.Message = string(payload.Detail)
+		}
+	case []interface{}:
+		// Request validation errors: [{"loc": ["body", "model_name"], "msg": "...", "type": "..."}]
+		var messages []string
+		for _, item := range detail {
+			validationError, _ := item.(map[string]interface{})
+			if validationError == nil {
+				continue
+			}
+			messages = append(messages, stringOrEmpty(validationError["msg"]))
+			if apiErr.Param == "" {
+				apiErr.Param = locationToParam(validationError["loc"])
+				apiErr.Type = stringOrEmpty(validationError["type"])
+			}
+		}
+		apiErr.Message = strings.Join(messages, "; ")
+	}
+}
+
+func (c *LitellmClient) redact(message string, secrets []string) string {
+	if c.ApiToken != "" {
+		message = strings.ReplaceAll(message, c.ApiToken, redactedValue)
+	}
+	for _, secret := range secrets {
+		message = strings.ReplaceAll(message, secret, redactedValue)
+	}
+	return secretPattern.ReplaceAllString(message, "${1}"+redactedValue)
+}
+
+// requestSecrets returns the values of the secret attributes of a JSON request body. Environment
+// references are not secrets, and values too short to be secrets are skipped to keep messages readable.
+func requestSecrets(body []byte) []string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil
+	}
+	var secrets []string
+	collectSecrets(value, false, &secrets)
+	return secrets
+}
+
+func collectSecrets(value interface{}, secret bool, secrets *[]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			collectSecrets(child, secret || secretContainers[key] || secretKeyPattern.MatchString(key), secrets)
+		}
+	case []interface{}:
+		for _, child := range v {
+			collectSecrets(child, secret, secrets)
+		}
+	case string:
+		if secret && len(v) >= 4 && !strings.HasPrefix(v, "os.environ/") {
+			*secrets = append(*secrets, v)
+		}
+	}
+}
+
+func locationToParam(location interface{}) string {
+	parts, _ := location.([]interface{})
+	var names []string
+	for _, part := range parts {
+		name := stringOrEmpty(part)
+		if name == "body" || name == "query" {
+			continue
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ".")
+}
+
+func unmarshalAny(raw json.RawMessage) interface{} {
+	var value interface{}
+	if len(raw) == 0 || json.Unmarshal(raw, &value) != nil {
+		return nil
+	}
+	return value
+}
+
+func stringOrEmpty(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	}
+}
@@ -0,0 +1,101 @@
+package litellmapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func checkResponseFrom(t *testing.T, statusCode int, body string) error {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewLitellmClient(server.URL, time.Second, 0)
+	client.ApiToken = "sk-master-token"
+	req, err := client.NewRequest(context.Background(), "POST", server.URL+"/model/new", nil)
+	assert.NoError(t, err)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	return client.CheckResponse(resp)
+}
+
+func TestCheckResponseParsesLitellmError(t *testing.T) {
+	err := checkResponseFrom(t, http.StatusBadRequest, `{"error": {"message": "Invalid model name passed in model=gpt-5", "type": "invalid_request_error", "param": "litellm_params.model", "code": 400}}`)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "POST", apiErr.Method)
+	assert.Equal(t, "/model/new", apiErr.Path)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, "Invalid model name passed in model=gpt-5", apiErr.Message)
+	assert.Equal(t, "invalid_request_error", apiErr.Type)
+	assert.Equal(t, "litellm_params.model", apiErr.Param)
+	assert.Equal(t, "400", apiErr.Code)
+}
+
+func TestCheckResponseParsesDetail(t *testing.T) {
+	err := checkResponseFrom(t, http.StatusBadRequest, `{"detail": {"error": "Model with id=unique-model-id already exists"}}`)
+	assert.EqualError(t, err, "POST /model/new returned status code 400: Model with id=unique-model-id already exists")
+
+	err = checkResponseFrom(t, http.StatusNotFound, `{"detail": "Model not found"}`)
+	assert.True(t, IsNotFound(err))
+
+	err = checkResponseFrom(t, http.StatusUnprocessableEntity, `{"detail": [{"loc": ["body", "model_name"], "msg": "Field required", "type": "missing"}]}`)
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Field required", apiErr.Message)
+	assert.Equal(t, "model_name", apiErr.Param)
+
+	err = checkResponseFrom(t, http.StatusBadGateway, `upstream unavailable`)
+	assert.EqualError(t, err, "POST /model/new returned status code 502: upstream unavailable")
+}
+
+func TestCheckResponseRedactsSecrets(t *testing.T) {
+	err := checkResponseFrom(t, http.StatusUnauthorized, `{"error": {"message": "Invalid proxy key sk-master-token, upstream rejected sk-proj-abcdef123 with Authorization: Bearer eyJhbGciOi.payload"}}`)
+
+	assert.NotContains(t, err.Error(), "sk-master-token")
+	assert.NotContains(t, err.Error(), "sk-proj-abcdef123")
+	assert.NotContains(t, err.Error(), "eyJhbGciOi")
+	assert.Contains(t, err.Error(), "Bearer <redacted>")
+}
+
+func TestDoJSONRedactsRequestSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]interface{}{"detail": "invalid request: " + string(body)})
+	}))
+	defer server.Close()
+
+	client := NewLitellmClient(server.URL, time.Second, 0)
+	request := map[string]interface{}{
+		"model_name": "azure-gpt-4o",
+		"litellm_params": map[string]interface{}{
+			"api_key":               "azure-key-0123456789",
+			"aws_secret_access_key": "aws-secret-0123456789",
+			"azure_ad_token":        "os.environ/AZURE_AD_TOKEN",
+		},
+		"credential_values": map[string]interface{}{"api_base": "https://private.openai.azure.com"},
+	}
+
+	err := client.doJSON(context.Background(), http.MethodPost, "/model/new", nil, request, nil)
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "azure-key-0123456789")
+	assert.NotContains(t, err.Error(), "aws-secret-0123456789")
+	assert.NotContains(t, err.Error(), "private.openai.azure.com")
+	assert.Contains(t, err.Error(), "os.environ/AZURE_AD_TOKEN")
+	assert.Contains(t, err.Error(), "azure-gpt-4o")
+}
@@ -0,0 +1,67 @@
+package litellmapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Guardrail is a guardrail stored in the proxy database, as accepted and returned by the /guardrails endpoints
+type Guardrail struct {
+	GuardrailID   string                 `json:"guardrail_id,omitempty"`
+	GuardrailName string                 `json:"guardrail_name"`
+	LitellmParams GuardrailParams        `json:"litellm_params"`
+	GuardrailInfo map[string]interface{} `json:"guardrail_info,omitempty"`
+}
+
+// GuardrailParams are the settings of a guardrail. Guardrail is the integration, e.g. presidio, lakera or bedrock,
+// and Mode is the point of the request lifecycle at which it runs.
+type GuardrailParams struct {
+	Guardrail                 string                 `json:"guardrail"`
+	Mode                      string                 `json:"mode"`
+	DefaultOn                 bool                   `json:"default_on"`
+	APIKey                    string                 `json:"api_key,omitempty"`
+	APIBase                   string                 `json:"api_base,omitempty"`
+	GuardrailIdentifier       string                 `json:"guardrailIdentifier,omitempty"`
+	GuardrailVersion          string                 `json:"guardrailVersion,omitempty"`
+	AWSRegionName             string                 `json:"aws_region_name,omitempty"`
+	MaskRequestContent        bool                   `json:"mask_request_content,omitempty"`
+	MaskResponseContent       bool                   `json:"mask_response_content,omitempty"`
+	OutputParsePII            bool                   `json:"output_parse_pii,omitempty"`
+	PresidioAnalyzerAPIBase   string                 `json:"presidio_analyzer_api_base,omitempty"`
+	PresidioAnonymizerAPIBase string                 `json:"presidio_anonymizer_api_base,omitempty"`
+	PIIEntitiesConfig         map[string]interface{} `json:"pii_entities_config,omitempty"`
+}
+
+type guardrailRequest struct {
+	Guardrail *Guardrail `json:"guardrail"`
+}
+
+func guardrailPath(guardrailID string) string {
+	return "/guardrails/" + url.PathEscape(guardrailID)
+}
+
+func (c *LitellmClient) CreateGuardrail(ctx context.Context, guardrail *Guardrail) (*Guardrail, error) {
+	var created Guardrail
+	if err := c.doJSON(ctx, http.MethodPost, "/guardrails", nil, guardrailRequest{guardrail}, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *LitellmClient) GetGuardrail(ctx context.Context, guardrailID string) (*Guardrail, error) {
+	var guardrail Guardrail
+	if err := c.doJSON(ctx, http.MethodGet, guardrailPath(guardrailID)+"/info", nil, nil, &guardrail); err != nil {
+		return nil, err
+	}
+	return &guardrail, nil
+}
+
+// UpdateGuardrail replaces the whole definition of the guardrail identified by guardrail.GuardrailID
+func (c *LitellmClient) UpdateGuardrail(ctx context.Context, guardrail *Guardrail) error {
+	return c.doJSON(ctx, http.MethodPut, guardrailPath(guardrail.GuardrailID), nil, guardrailRequest{guardrail}, nil)
+}
+
+func (c *LitellmClient) DeleteGuardrail(ctx context.Context, guardrailID string) error {
+	return c.doJSON(ctx, http.MethodDelete, guardrailPath(guardrailID), nil, nil, nil)
+}
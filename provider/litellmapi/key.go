@@ -0,0 +1,110 @@
+package litellmapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Key is a virtual API key. Key holds the raw key and is only returned by /key/generate,
+// Token is the hashed form the proxy uses to identify the key afterwards.
+type Key struct {
+	Key            string                 `json:"key,omitempty"`
+	Token          string                 `json:"token,omitempty"`
+	TokenID        string                 `json:"token_id,omitempty"`
+	KeyAlias       string                 `json:"key_alias,omitempty"`
+	Models         []string               `json:"models"`
+	MaxBudget      *float64               `json:"max_budget,omitempty"`
+	BudgetDuration string                 `json:"budget_duration,omitempty"`
+	TpmLimit       *int64                 `json:"tpm_limit,omitempty"`
+	RpmLimit       *int64                 `json:"rpm_limit,omitempty"`
+	TeamID         string                 `json:"team_id,omitempty"`
+	UserID         string                 `json:"user_id,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	Duration       string                 `json:"duration,omitempty"`
+	Aliases        map[string]interface{} `json:"aliases,omitempty"`
+	Expires        string                 `json:"expires,omitempty"`
+}
+
+// HashedToken returns the hashed token identifying the key
+func (k *Key) HashedToken() string {
+	if k.TokenID != "" {
+		return k.TokenID
+	}
+	return k.Token
+}
+
+type keyInfoResponse struct {
+	Key  string `json:"key"`
+	Info Key    `json:"info"`
+}
+
+type keyListResponse struct {
+	Keys []Key `json:"keys"`
+}
+
+// GenerateKey creates a key, the returned Key carries the raw key and its hashed token
+func (c *LitellmClient) GenerateKey(ctx context.Context, key *Key) (*Key, error) {
+	var generated Key
+	if err := c.doJSON(ctx, http.MethodPost, "/key/generate", nil, key, &generated); err != nil {
+		return nil, err
+	}
+	return &generated, nil
+}
+
+// GetKey returns the key identified by its raw key or hashed token
+func (c *LitellmClient) GetKey(ctx context.Context, token string) (*Key, error) {
+	var response keyInfoResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/key/info", url.Values{"key": {token}}, nil, &response); err != nil {
+		return nil, err
+	}
+	if response.Info.HashedToken() == "" {
+		response.Info.Token = token
+	}
+	return &response.Info, nil
+}
+
+// GetKeyByAlias returns the single key using the given alias
+func (c *LitellmClient) GetKeyByAlias(ctx context.Context, alias string) (*Key, error) {
+	var response keyListResponse
+	query := url.Values{"key_alias": {alias}, "return_full_object": {"true"}}
+	if err := c.doJSON(ctx, http.MethodGet, "/key/list", query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	var matches []Key
+	for _, key := range response.Keys {
+		if key.KeyAlias == alias {
+			matches = append(matches, key)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, &APIError{
+			Method:     http.MethodGet,
+			Path:       "/key/list",
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("key with alias %s not found", alias),
+		}
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("key_alias %s matches %d keys", alias, len(matches))
+	}
+}
+
+// UpdateKey updates the key identified by key.Key, which may be the raw key or its hashed token.
+// The cleared fields are sent as null so that the proxy removes them.
+func (c *LitellmClient) UpdateKey(ctx context.Context, key *Key, cleared ...string) error {
+	request, err := withNulls(key, cleared)
+	if err != nil {
+		return err
+	}
+	return c.doJSON(ctx, http.MethodPost, "/key/update", nil, request, nil)
+}
+
+func (c *LitellmClient) DeleteKey(ctx context.Context, token string) error {
+	return c.doJSON(ctx, http.MethodPost, "/key/delete", nil, map[string][]string{"keys": {token}}, nil)
+}
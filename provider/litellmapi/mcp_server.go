@@ -0,0 +1,57 @@
+package litellmapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// MCPServer is a Model Context Protocol server fronted by the proxy
+type MCPServer struct {
+	ServerID        string                 `json:"server_id,omitempty"`
+	ServerName      string                 `json:"server_name"`
+	Description     string                 `json:"description,omitempty"`
+	URL             string                 `json:"url,omitempty"`
+	Transport       string                 `json:"transport,omitempty"`
+	AuthType        string                 `json:"auth_type,omitempty"`
+	Credentials     *MCPCredentials        `json:"credentials,omitempty"`
+	Command         string                 `json:"command,omitempty"`
+	Args            []string               `json:"args,omitempty"`
+	Env             map[string]interface{} `json:"env,omitempty"`
+	AllowedTools    []string               `json:"allowed_tools"`
+	MCPAccessGroups []string               `json:"mcp_access_groups"`
+}
+
+// MCPCredentials holds the secret the proxy uses to authenticate to the MCP server, it is never returned
+type MCPCredentials struct {
+	AuthValue string `json:"auth_value,omitempty"`
+}
+
+func mcpServerPath(serverID string) string {
+	return "/v1/mcp/server/" + url.PathEscape(serverID)
+}
+
+func (c *LitellmClient) CreateMCPServer(ctx context.Context, server *MCPServer) (*MCPServer, error) {
+	var created MCPServer
+	if err := c.doJSON(ctx, http.MethodPost, "/v1/mcp/server", nil, server, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *LitellmClient) GetMCPServer(ctx context.Context, serverID string) (*MCPServer, error) {
+	var server MCPServer
+	if err := c.doJSON(ctx, http.MethodGet, mcpServerPath(serverID), nil, nil, &server); err != nil {
+		return nil, err
+	}
+	return &server, nil
+}
+
+// UpdateMCPServer updates the server identified by server.ServerID
+func (c *LitellmClient) UpdateMCPServer(ctx context.Context, server *MCPServer) error {
+	return c.doJSON(ctx, http.MethodPut, "/v1/mcp/server", nil, server, nil)
+}
+
+func (c *LitellmClient) DeleteMCPServer(ctx context.Context, serverID string) error {
+	return c.doJSON(ctx, http.MethodDelete, mcpServerPath(serverID), nil, nil, nil)
+}
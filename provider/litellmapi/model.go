@@ -0,0 +1,100 @@
+package litellmapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Model is a model deployment as accepted by /model/new and returned by /model/info
+type Model struct {
+	ModelName     string                 `json:"model_name"`
+	LitellmParams map[string]interface{} `json:"litellm_params"`
+	ModelInfo     map[string]interface{} `json:"model_info,omitempty"`
+}
+
+// ID returns model_info.id, the identifier of the deployment
+func (m *Model) ID() string {
+	if m.ModelInfo == nil || m.ModelInfo["id"] == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", m.ModelInfo["id"])
+}
+
+// AccessGroups returns the access groups listed in model_info.access_groups
+func (m *Model) AccessGroups() []string {
+	groups, _ := m.ModelInfo["access_groups"].([]interface{})
+	result := make([]string, 0, len(groups))
+	for _, group := range groups {
+		if name, ok := group.(string); ok {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+type modelInfoResponse struct {
+	Data []Model `json:"data"`
+}
+
+func (c *LitellmClient) CreateModel(ctx context.Context, model *Model) error {
+	return c.doJSON(ctx, http.MethodPost, "/model/new", nil, model, nil)
+}
+
+// GetModel returns the deployment with the given ID, a missing deployment is reported as a not found APIError
+func (c *LitellmClient) GetModel(ctx context.Context, id string) (*Model, error) {
+	var response modelInfoResponse
+	err := c.doJSON(ctx, http.MethodGet, "/model/info", url.Values{"litellm_model_id": {id}}, nil, &response)
+	if err != nil {
+		return nil, modelNotFound(err)
+	}
+
+	for _, model := range response.Data {
+		if model.ID() == id {
+			return &model, nil
+		}
+	}
+
+	return nil, &APIError{
+		Method:     http.MethodGet,
+		Path:       "/model/info",
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("model %s not found", id),
+	}
+}
+
+// ListModels returns every deployment known by the proxy
+func (c *LitellmClient) ListModels(ctx context.Context) ([]Model, error) {
+	var response modelInfoResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/model/info", nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
+
+func (c *LitellmClient) UpdateModel(ctx context.Context, model *Model) error {
+	return c.doJSON(ctx, http.MethodPost, "/model/update", nil, model, nil)
+}
+
+// UpdateModelInfo patches the model_info of the deployment, leaving its litellm_params untouched
+func (c *LitellmClient) UpdateModelInfo(ctx context.Context, id string, modelInfo map[string]interface{}) error {
+	path := "/model/" + url.PathEscape(id) + "/update"
+	return c.doJSON(ctx, http.MethodPatch, path, nil, map[string]interface{}{"model_info": modelInfo}, nil)
+}
+
+func (c *LitellmClient) DeleteModel(ctx context.Context, id string) error {
+	return modelNotFound(c.doJSON(ctx, http.MethodPost, "/model/delete", nil, map[string]string{"id": id}, nil))
+}
+
+// modelNotFound marks the 400 the proxy answers for unknown model IDs, e.g. "Model id = ... not found on
+// litellm proxy", as a not found error
+func modelNotFound(err error) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest && strings.Contains(apiErr.Message, "not found") {
+		apiErr.notFound = true
+	}
+	return err
+}
@@ -0,0 +1,95 @@
+package litellmapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelCRUD(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	requests := map[string]map[string]interface{}{}
+	recordRequest := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		body := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests[r.URL.Path] = body
+		w.Write([]byte(`{}`))
+	}
+	mux.HandleFunc("/model/new", recordRequest)
+	mux.HandleFunc("/model/update", recordRequest)
+	mux.HandleFunc("/model/delete", recordRequest)
+	mux.HandleFunc("/model/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		switch r.URL.Query().Get("litellm_model_id") {
+		case "":
+			w.Write([]byte(`{"data": [
+				{"model_name": "model-a", "litellm_params": {"model": "gpt-4"}, "model_info": {"id": "model-id-1"}},
+				{"model_name": "model-b", "litellm_params": {"model": "gpt-4o"}, "model_info": {"id": "model-id-2"}}
+			]}`))
+		case "model-id-1":
+			w.Write([]byte(`{"data": [{"model_name": "model-a", "litellm_params": {"model": "gpt-4", "rpm": 10}, "model_info": {"id": "model-id-1"}}]}`))
+		case "model-id-3":
+			w.Write([]byte(`{"data": []}`))
+		case "model-id-5":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "Model id = model-id-5 not found on litellm proxy"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"detail": {"error": "invalid id"}}`))
+		}
+	})
+
+	client := NewLitellmClient(server.URL+"/", time.Second, 0)
+	client.ApiToken = "test-token"
+	ctx := context.Background()
+
+	model := &Model{
+		ModelName:     "model-a",
+		LitellmParams: map[string]interface{}{"model": "gpt-4"},
+		ModelInfo:     map[string]interface{}{"id": "model-id-1"},
+	}
+	assert.Equal(t, "model-id-1", model.ID())
+
+	assert.NoError(t, client.CreateModel(ctx, model))
+	assert.Equal(t, "model-a", requests["/model/new"]["model_name"])
+	assert.Equal(t, map[string]interface{}{"model": "gpt-4"}, requests["/model/new"]["litellm_params"])
+
+	assert.NoError(t, client.UpdateModel(ctx, model))
+	assert.Equal(t, map[string]interface{}{"id": "model-id-1"}, requests["/model/update"]["model_info"])
+
+	got, err := client.GetModel(ctx, "model-id-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "model-a", got.ModelName)
+	assert.Equal(t, float64(10), got.LitellmParams["rpm"])
+
+	_, err = client.GetModel(ctx, "model-id-3")
+	assert.True(t, IsNotFound(err))
+
+	_, err = client.GetModel(ctx, "model-id-4")
+	assert.EqualError(t, err, "GET /model/info returned status code 400: invalid id")
+	assert.False(t, IsNotFound(err))
+
+	// The proxy answers 400 for unknown model IDs
+	_, err = client.GetModel(ctx, "model-id-5")
+	assert.True(t, IsNotFound(err))
+	assert.EqualError(t, err, "GET /model/info returned status code 400: Model id = model-id-5 not found on litellm proxy")
+
+	models, err := client.ListModels(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, models, 2)
+	assert.Equal(t, "model-id-2", models[1].ID())
+
+	assert.NoError(t, client.DeleteModel(ctx, "model-id-1"))
+	assert.Equal(t, map[string]interface{}{"id": "model-id-1"}, requests["/model/delete"])
+}
@@ -0,0 +1,175 @@
+package litellmapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Organization groups teams of the proxy under common budgets and model access
+type Organization struct {
+	OrganizationID    string                 `json:"organization_id,omitempty"`
+	OrganizationAlias string                 `json:"organization_alias"`
+	Models            []string               `json:"models"`
+	MaxBudget         *float64               `json:"max_budget,omitempty"`
+	BudgetDuration    string                 `json:"budget_duration,omitempty"`
+	TpmLimit          *int64                 `json:"tpm_limit,omitempty"`
+	RpmLimit          *int64                 `json:"rpm_limit,omitempty"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// OrganizationMember is a user of an organization, identified by UserID or UserEmail
+type OrganizationMember struct {
+	UserID    string `json:"user_id,omitempty"`
+	UserEmail string `json:"user_email,omitempty"`
+	Role      string `json:"role,omitempty"`
+
+	// MaxBudgetInOrganization is sent next to the member rather than in it
+	MaxBudgetInOrganization *float64 `json:"-"`
+}
+
+// budgetTable is the budget attached to organizations and memberships in /organization/info
+type budgetTable struct {
+	MaxBudget      *float64 `json:"max_budget"`
+	BudgetDuration string   `json:"budget_duration"`
+	TpmLimit       *int64   `json:"tpm_limit"`
+	RpmLimit       *int64   `json:"rpm_limit"`
+}
+
+type organizationInfoResponse struct {
+	Organization
+	LitellmBudgetTable *budgetTable `json:"litellm_budget_table"`
+	Members            []struct {
+		UserID             string       `json:"user_id"`
+		UserRole           string       `json:"user_role"`
+		LitellmBudgetTable *budgetTable `json:"litellm_budget_table"`
+		User               *struct {
+			UserEmail string `json:"user_email"`
+		} `json:"user"`
+	} `json:"members"`
+}
+
+type organizationMemberAddRequest struct {
+	OrganizationID          string               `json:"organization_id"`
+	Member                  []OrganizationMember `json:"member"`
+	MaxBudgetInOrganization *float64             `json:"max_budget_in_organization,omitempty"`
+}
+
+type organizationMemberUpdateRequest struct {
+	OrganizationID          string   `json:"organization_id"`
+	UserID                  string   `json:"user_id,omitempty"`
+	UserEmail               string   `json:"user_email,omitempty"`
+	Role                    string   `json:"role,omitempty"`
+	MaxBudgetInOrganization *float64 `json:"max_budget_in_organization,omitempty"`
+}
+
+func (c *LitellmClient) CreateOrganization(ctx context.Context, organization *Organization) (*Organization, error) {
+	var created Organization
+	if err := c.doJSON(ctx, http.MethodPost, "/organization/new", nil, organization, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *LitellmClient) getOrganizationInfo(ctx context.Context, organizationID string) (*organizationInfoResponse, error) {
+	var response organizationInfoResponse
+	query := url.Values{"organization_id": {organizationID}}
+	if err := c.doJSON(ctx, http.MethodGet, "/organization/info", query, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetOrganization returns the organization with its budget settings, which the proxy stores in a separate budget
+func (c *LitellmClient) GetOrganization(ctx context.Context, organizationID string) (*Organization, error) {
+	response, err := c.getOrganizationInfo(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	organization := response.Organization
+	if budget := response.LitellmBudgetTable; budget != nil {
+		organization.MaxBudget = budget.MaxBudget
+		organization.BudgetDuration = budget.BudgetDuration
+		organization.TpmLimit = budget.TpmLimit
+		organization.RpmLimit = budget.RpmLimit
+	}
+	return &organization, nil
+}
+
+// UpdateOrganization updates the organization, the cleared fields are sent as null so that the proxy removes them
+func (c *LitellmClient) UpdateOrganization(ctx context.Context, organization *Organization, cleared ...string) error {
+	request, err := withNulls(organization, cleared)
+	if err != nil {
+		return err
+	}
+	return c.doJSON(ctx, http.MethodPatch, "/organization/update", nil, request, nil)
+}
+
+func (c *LitellmClient) DeleteOrganization(ctx context.Context, organizationID string) error {
+	request := map[string][]string{"organization_ids": {organizationID}}
+	return c.doJSON(ctx, http.MethodDelete, "/organization/delete", nil, request, nil)
+}
+
+func (c *LitellmClient) AddOrganizationMember(ctx context.Context, organizationID string, member *OrganizationMember) error {
+	request := organizationMemberAddRequest{
+		OrganizationID:          organizationID,
+		Member:                  []OrganizationMember{*member},
+		MaxBudgetInOrganization: member.MaxBudgetInOrganization,
+	}
+	return c.doJSON(ctx, http.MethodPost, "/organization/member_add", nil, request, nil)
+}
+
+// GetOrganizationMember looks the member up in the organization by user ID, or by email when no user ID is given
+func (c *LitellmClient) GetOrganizationMember(ctx context.Context, organizationID string, userID string, userEmail string) (*OrganizationMember, error) {
+	response, err := c.getOrganizationInfo(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, membership := range response.Members {
+		member := OrganizationMember{UserID: membership.UserID, Role: membership.UserRole}
+		if membership.User != nil {
+			member.UserEmail = membership.User.UserEmail
+		}
+		if (userID != "" && member.UserID != userID) || (userID == "" && member.UserEmail != userEmail) {
+			continue
+		}
+		if membership.LitellmBudgetTable != nil {
+			member.MaxBudgetInOrganization = membership.LitellmBudgetTable.MaxBudget
+		}
+		return &member, nil
+	}
+
+	identifier := userID
+	if identifier == "" {
+		identifier = userEmail
+	}
+	return nil, &APIError{
+		Method:     http.MethodGet,
+		Path:       "/organization/info",
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("member %s not found in organization %s", identifier, organizationID),
+	}
+}
+
+func (c *LitellmClient) UpdateOrganizationMember(ctx context.Context, organizationID string, member *OrganizationMember) error {
+	request := organizationMemberUpdateRequest{
+		OrganizationID:          organizationID,
+		UserID:                  member.UserID,
+		UserEmail:               member.UserEmail,
+		Role:                    member.Role,
+		MaxBudgetInOrganization: member.MaxBudgetInOrganization,
+	}
+	return c.doJSON(ctx, http.MethodPatch, "/organization/member_update", nil, request, nil)
+}
+
+func (c *LitellmClient) RemoveOrganizationMember(ctx context.Context, organizationID string, member *OrganizationMember) error {
+	request := organizationMemberUpdateRequest{
+		OrganizationID: organizationID,
+		UserID:         member.UserID,
+		UserEmail:      member.UserEmail,
+	}
+	return c.doJSON(ctx, http.MethodDelete, "/organization/member_delete", nil, request, nil)
+}
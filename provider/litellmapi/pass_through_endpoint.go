@@ -0,0 +1,60 @@
+package litellmapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PassThroughEndpoint forwards the requests received by the proxy on Path to Target
+type PassThroughEndpoint struct {
+	Path           string                 `json:"path"`
+	Target         string                 `json:"target"`
+	Headers        map[string]interface{} `json:"headers,omitempty"`
+	ForwardHeaders bool                   `json:"forward_headers"`
+	IncludeSubpath bool                   `json:"include_subpath"`
+	Auth           bool                   `json:"auth"`
+}
+
+type passThroughEndpointsResponse struct {
+	Endpoints []PassThroughEndpoint `json:"endpoints"`
+}
+
+func (c *LitellmClient) CreatePassThroughEndpoint(ctx context.Context, endpoint *PassThroughEndpoint) error {
+	return c.doJSON(ctx, http.MethodPost, "/config/pass_through_endpoint", nil, endpoint, nil)
+}
+
+// ListPassThroughEndpoints returns every pass-through endpoint of the proxy config
+func (c *LitellmClient) ListPassThroughEndpoints(ctx context.Context) ([]PassThroughEndpoint, error) {
+	var response passThroughEndpointsResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/config/pass_through_endpoint", nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Endpoints, nil
+}
+
+// GetPassThroughEndpoint returns the endpoint serving path, a missing endpoint is reported as a not found APIError
+func (c *LitellmClient) GetPassThroughEndpoint(ctx context.Context, path string) (*PassThroughEndpoint, error) {
+	endpoints, err := c.ListPassThroughEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.Path == path {
+			return &endpoint, nil
+		}
+	}
+
+	return nil, &APIError{
+		Method:     http.MethodGet,
+		Path:       "/config/pass_through_endpoint",
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("pass-through endpoint %s not found", path),
+	}
+}
+
+func (c *LitellmClient) DeletePassThroughEndpoint(ctx context.Context, path string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/config/pass_through_endpoint", url.Values{"endpoint_id": {path}}, nil, nil)
+}
@@ -0,0 +1,52 @@
+package litellmapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Prompt is a prompt template managed by the proxy and referenced by apps through PromptID
+type Prompt struct {
+	PromptID      string                 `json:"prompt_id"`
+	LitellmParams map[string]interface{} `json:"litellm_params"`
+	PromptInfo    *PromptInfo            `json:"prompt_info,omitempty"`
+	Version       int                    `json:"version,omitempty"`
+}
+
+type PromptInfo struct {
+	PromptType string `json:"prompt_type,omitempty"`
+}
+
+type promptInfoResponse struct {
+	PromptSpec Prompt `json:"prompt_spec"`
+}
+
+func promptPath(promptID string) string {
+	return "/prompts/" + url.PathEscape(promptID)
+}
+
+func (c *LitellmClient) CreatePrompt(ctx context.Context, prompt *Prompt) (*Prompt, error) {
+	var created Prompt
+	if err := c.doJSON(ctx, http.MethodPost, "/prompts", nil, prompt, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *LitellmClient) GetPrompt(ctx context.Context, promptID string) (*Prompt, error) {
+	var response promptInfoResponse
+	if err := c.doJSON(ctx, http.MethodGet, promptPath(promptID)+"/info", nil, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response.PromptSpec, nil
+}
+
+// UpdatePrompt replaces the prompt identified by prompt.PromptID, the proxy records it as a new version
+func (c *LitellmClient) UpdatePrompt(ctx context.Context, prompt *Prompt) error {
+	return c.doJSON(ctx, http.MethodPut, promptPath(prompt.PromptID), nil, prompt, nil)
+}
+
+func (c *LitellmClient) DeletePrompt(ctx context.Context, promptID string) error {
+	return c.doJSON(ctx, http.MethodDelete, promptPath(promptID), nil, nil, nil)
+}
@@ -0,0 +1,70 @@
+package litellmapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Tag is a request tag used for cost attribution and tag based routing. Models holds the IDs
+// of the deployments requests carrying the tag are routed to.
+type Tag struct {
+	Name           string   `json:"name"`
+	Description    string   `json:"description,omitempty"`
+	Models         []string `json:"models"`
+	MaxBudget      *float64 `json:"max_budget,omitempty"`
+	BudgetDuration string   `json:"budget_duration,omitempty"`
+	TpmLimit       *int64   `json:"tpm_limit,omitempty"`
+	RpmLimit       *int64   `json:"rpm_limit,omitempty"`
+}
+
+type tagInfoResponse struct {
+	Tag
+	LitellmBudgetTable *budgetTable `json:"litellm_budget_table"`
+}
+
+func (c *LitellmClient) CreateTag(ctx context.Context, tag *Tag) error {
+	return c.doJSON(ctx, http.MethodPost, "/tag/new", nil, tag, nil)
+}
+
+// GetTag returns the tag with its budget settings, a missing tag is reported as a not found APIError
+func (c *LitellmClient) GetTag(ctx context.Context, name string) (*Tag, error) {
+	var response map[string]tagInfoResponse
+	request := map[string][]string{"names": {name}}
+	if err := c.doJSON(ctx, http.MethodPost, "/tag/info", nil, request, &response); err != nil {
+		return nil, err
+	}
+
+	info, found := response[name]
+	if !found {
+		return nil, &APIError{
+			Method:     http.MethodPost,
+			Path:       "/tag/info",
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("tag %s not found", name),
+		}
+	}
+
+	tag := info.Tag
+	tag.Name = name
+	if budget := info.LitellmBudgetTable; budget != nil {
+		tag.MaxBudget = budget.MaxBudget
+		tag.BudgetDuration = budget.BudgetDuration
+		tag.TpmLimit = budget.TpmLimit
+		tag.RpmLimit = budget.RpmLimit
+	}
+	return &tag, nil
+}
+
+// UpdateTag updates the tag identified by tag.Name, the cleared fields are sent as null so that the proxy removes them
+func (c *LitellmClient) UpdateTag(ctx context.Context, tag *Tag, cleared ...string) error {
+	request, err := withNulls(tag, cleared)
+	if err != nil {
+		return err
+	}
+	return c.doJSON(ctx, http.MethodPost, "/tag/update", nil, request, nil)
+}
+
+func (c *LitellmClient) DeleteTag(ctx context.Context, name string) error {
+	return c.doJSON(ctx, http.MethodPost, "/tag/delete", nil, map[string]string{"name": name}, nil)
+}
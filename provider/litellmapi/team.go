@@ -0,0 +1,58 @@
+package litellmapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Team is a LiteLLM team, used to group keys and users under common budgets and model access
+type Team struct {
+	TeamID         string                 `json:"team_id,omitempty"`
+	TeamAlias      string                 `json:"team_alias,omitempty"`
+	Models         []string               `json:"models"`
+	MaxBudget      *float64               `json:"max_budget,omitempty"`
+	BudgetDuration string                 `json:"budget_duration,omitempty"`
+	TpmLimit       *int64                 `json:"tpm_limit,omitempty"`
+	RpmLimit       *int64                 `json:"rpm_limit,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	Blocked        bool                   `json:"blocked"`
+	OrganizationID string                 `json:"organization_id,omitempty"`
+
+	MembersWithRoles []TeamMember `json:"members_with_roles,omitempty"`
+}
+
+type teamInfoResponse struct {
+	TeamID          string           `json:"team_id"`
+	TeamInfo        Team             `json:"team_info"`
+	TeamMemberships []teamMembership `json:"team_memberships"`
+}
+
+func (c *LitellmClient) CreateTeam(ctx context.Context, team *Team) (*Team, error) {
+	var created Team
+	if err := c.doJSON(ctx, http.MethodPost, "/team/new", nil, team, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *LitellmClient) GetTeam(ctx context.Context, teamID string) (*Team, error) {
+	var response teamInfoResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/team/info", url.Values{"team_id": {teamID}}, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response.TeamInfo, nil
+}
+
+// UpdateTeam updates the team identified by team.TeamID, the cleared fields are sent as null so that the proxy removes them
+func (c *LitellmClient) UpdateTeam(ctx context.Context, team *Team, cleared ...string) error {
+	request, err := withNulls(team, cleared)
+	if err != nil {
+		return err
+	}
+	return c.doJSON(ctx, http.MethodPost, "/team/update", nil, request, nil)
+}
+
+func (c *LitellmClient) DeleteTeam(ctx context.Context, teamID string) error {
+	return c.doJSON(ctx, http.MethodPost, "/team/delete", nil, map[string][]string{"team_ids": {teamID}}, nil)
+}
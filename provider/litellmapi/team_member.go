@@ -0,0 +1,100 @@
+package litellmapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TeamMember is a user of a team, identified by UserID or UserEmail
+type TeamMember struct {
+	UserID    string `json:"user_id,omitempty"`
+	UserEmail string `json:"user_email,omitempty"`
+	Role      string `json:"role,omitempty"`
+
+	// MaxBudgetInTeam is the budget of the member within the team, it is sent next to the member rather than in it
+	MaxBudgetInTeam *float64 `json:"-"`
+}
+
+// teamMembership carries the per member budget returned by /team/info
+type teamMembership struct {
+	UserID             string `json:"user_id"`
+	LitellmBudgetTable *struct {
+		MaxBudget *float64 `json:"max_budget"`
+	} `json:"litellm_budget_table"`
+}
+
+type teamMemberAddRequest struct {
+	TeamID          string     `json:"team_id"`
+	Member          TeamMember `json:"member"`
+	MaxBudgetInTeam *float64   `json:"max_budget_in_team,omitempty"`
+}
+
+type teamMemberUpdateRequest struct {
+	TeamID          string   `json:"team_id"`
+	UserID          string   `json:"user_id,omitempty"`
+	UserEmail       string   `json:"user_email,omitempty"`
+	Role            string   `json:"role,omitempty"`
+	MaxBudgetInTeam *float64 `json:"max_budget_in_team,omitempty"`
+}
+
+func (c *LitellmClient) AddTeamMember(ctx context.Context, teamID string, member *TeamMember) error {
+	request := teamMemberAddRequest{
+		TeamID:          teamID,
+		Member:          *member,
+		MaxBudgetInTeam: member.MaxBudgetInTeam,
+	}
+	return c.doJSON(ctx, http.MethodPost, "/team/member_add", nil, request, nil)
+}
+
+// GetTeamMember looks the member up in the team by user ID, or by email when no user ID is given
+func (c *LitellmClient) GetTeamMember(ctx context.Context, teamID string, userID string, userEmail string) (*TeamMember, error) {
+	var response teamInfoResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/team/info", url.Values{"team_id": {teamID}}, nil, &response); err != nil {
+		return nil, err
+	}
+
+	for _, member := range response.TeamInfo.MembersWithRoles {
+		if (userID != "" && member.UserID != userID) || (userID == "" && member.UserEmail != userEmail) {
+			continue
+		}
+		for _, membership := range response.TeamMemberships {
+			if membership.UserID == member.UserID && membership.LitellmBudgetTable != nil {
+				member.MaxBudgetInTeam = membership.LitellmBudgetTable.MaxBudget
+			}
+		}
+		return &member, nil
+	}
+
+	identifier := userID
+	if identifier == "" {
+		identifier = userEmail
+	}
+	return nil, &APIError{
+		Method:     http.MethodGet,
+		Path:       "/team/info",
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("member %s not found in team %s", identifier, teamID),
+	}
+}
+
+func (c *LitellmClient) UpdateTeamMember(ctx context.Context, teamID string, member *TeamMember) error {
+	request := teamMemberUpdateRequest{
+		TeamID:          teamID,
+		UserID:          member.UserID,
+		UserEmail:       member.UserEmail,
+		Role:            member.Role,
+		MaxBudgetInTeam: member.MaxBudgetInTeam,
+	}
+	return c.doJSON(ctx, http.MethodPost, "/team/member_update", nil, request, nil)
+}
+
+func (c *LitellmClient) RemoveTeamMember(ctx context.Context, teamID string, member *TeamMember) error {
+	request := teamMemberUpdateRequest{
+		TeamID:    teamID,
+		UserID:    member.UserID,
+		UserEmail: member.UserEmail,
+	}
+	return c.doJSON(ctx, http.MethodPost, "/team/member_delete", nil, request, nil)
+}
@@ -0,0 +1,81 @@
+package litellmapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// User is an internal user of the proxy
+type User struct {
+	UserID         string                 `json:"user_id,omitempty"`
+	UserEmail      string                 `json:"user_email,omitempty"`
+	UserRole       string                 `json:"user_role,omitempty"`
+	Models         []string               `json:"models"`
+	MaxBudget      *float64               `json:"max_budget,omitempty"`
+	BudgetDuration string                 `json:"budget_duration,omitempty"`
+	TpmLimit       *int64                 `json:"tpm_limit,omitempty"`
+	RpmLimit       *int64                 `json:"rpm_limit,omitempty"`
+	Teams          []string               `json:"teams,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type newUserRequest struct {
+	User
+	AutoCreateKey bool `json:"auto_create_key"`
+}
+
+// NewUserResponse is returned by /user/new, Key holds the key generated for the user when auto_create_key is set
+type NewUserResponse struct {
+	User
+	Key string `json:"key"`
+}
+
+type userInfoResponse struct {
+	UserID   string `json:"user_id"`
+	UserInfo *User  `json:"user_info"`
+}
+
+func (c *LitellmClient) CreateUser(ctx context.Context, user *User, autoCreateKey bool) (*NewUserResponse, error) {
+	var created NewUserResponse
+	request := newUserRequest{User: *user, AutoCreateKey: autoCreateKey}
+	if err := c.doJSON(ctx, http.MethodPost, "/user/new", nil, request, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *LitellmClient) GetUser(ctx context.Context, userID string) (*User, error) {
+	var response userInfoResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/user/info", url.Values{"user_id": {userID}}, nil, &response); err != nil {
+		return nil, err
+	}
+
+	// Older proxies answer unknown users with an empty user_info rather than a 404
+	if response.UserInfo == nil {
+		return nil, &APIError{
+			Method:     http.MethodGet,
+			Path:       "/user/info",
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("user %s not found", userID),
+		}
+	}
+	return response.UserInfo, nil
+}
+
+// UpdateUser updates the user, team membership is managed through the team member endpoints instead. The
+// cleared fields are sent as null so that the proxy removes them.
+func (c *LitellmClient) UpdateUser(ctx context.Context, user *User, cleared ...string) error {
+	update := *user
+	update.Teams = nil
+	request, err := withNulls(update, cleared)
+	if err != nil {
+		return err
+	}
+	return c.doJSON(ctx, http.MethodPost, "/user/update", nil, request, nil)
+}
+
+func (c *LitellmClient) DeleteUser(ctx context.Context, userID string) error {
+	return c.doJSON(ctx, http.MethodPost, "/user/delete", nil, map[string][]string{"user_ids": {userID}}, nil)
+}
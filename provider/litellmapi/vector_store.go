@@ -0,0 +1,44 @@
+package litellmapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// VectorStore is a vector store of an LLM provider registered with the proxy, e.g. a Bedrock knowledge base
+type VectorStore struct {
+	VectorStoreID          string                 `json:"vector_store_id"`
+	CustomLlmProvider      string                 `json:"custom_llm_provider"`
+	VectorStoreName        string                 `json:"vector_store_name,omitempty"`
+	VectorStoreDescription string                 `json:"vector_store_description,omitempty"`
+	VectorStoreMetadata    map[string]interface{} `json:"vector_store_metadata,omitempty"`
+	LitellmCredentialName  string                 `json:"litellm_credential_name,omitempty"`
+}
+
+type vectorStoreRequest struct {
+	VectorStoreID string `json:"vector_store_id"`
+}
+
+type vectorStoreInfoResponse struct {
+	VectorStore VectorStore `json:"vector_store"`
+}
+
+func (c *LitellmClient) CreateVectorStore(ctx context.Context, vectorStore *VectorStore) error {
+	return c.doJSON(ctx, http.MethodPost, "/vector_store/new", nil, vectorStore, nil)
+}
+
+func (c *LitellmClient) GetVectorStore(ctx context.Context, vectorStoreID string) (*VectorStore, error) {
+	var response vectorStoreInfoResponse
+	if err := c.doJSON(ctx, http.MethodPost, "/vector_store/info", nil, vectorStoreRequest{vectorStoreID}, &response); err != nil {
+		return nil, err
+	}
+	return &response.VectorStore, nil
+}
+
+func (c *LitellmClient) UpdateVectorStore(ctx context.Context, vectorStore *VectorStore) error {
+	return c.doJSON(ctx, http.MethodPost, "/vector_store/update", nil, vectorStore, nil)
+}
+
+func (c *LitellmClient) DeleteVectorStore(ctx context.Context, vectorStoreID string) error {
+	return c.doJSON(ctx, http.MethodPost, "/vector_store/delete", nil, vectorStoreRequest{vectorStoreID}, nil)
+}
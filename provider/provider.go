@@ -4,11 +4,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gzamboni/terraform-provider-litellm/provider/jwtauth"
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
 )
 
 type AuthType string
@@ -111,9 +114,39 @@ func NewProvider() *schema.Provider {
 				Description: "Describe in which attribute is the token in the HTTP Response from the IdP to get the token.",
 				Default:     "access_token",
 			},
+			"request_timeout": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          litellmapi.DefaultRequestTimeout,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Timeout in seconds for each HTTP request sent to the LiteLLM API and the IdP token endpoint.",
+			},
+			"max_retries": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          litellmapi.DefaultMaxRetries,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Maximum number of retries for requests failing with a connection error, a 429 or a 5xx status code. Retries use exponential backoff with jitter and honor the `Retry-After` header. Requests creating objects are only retried on a 429 or a connection failure, so that they are never sent twice.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"litellm_model": resourceModel(),
+			"litellm_model":                 resourceModel(),
+			"litellm_key":                   resourceKey(),
+			"litellm_team":                  resourceTeam(),
+			"litellm_team_member":           resourceTeamMember(),
+			"litellm_user":                  resourceUser(),
+			"litellm_organization":          resourceOrganization(),
+			"litellm_organization_member":   resourceOrganizationMember(),
+			"litellm_budget":                resourceBudget(),
+			"litellm_customer":              resourceCustomer(),
+			"litellm_credential":            resourceCredential(),
+			"litellm_guardrail":             resourceGuardrail(),
+			"litellm_mcp_server":            resourceMCPServer(),
+			"litellm_tag":                   resourceTag(),
+			"litellm_pass_through_endpoint": resourcePassThroughEndpoint(),
+			"litellm_vector_store":          resourceVectorStore(),
+			"litellm_prompt":                resourcePrompt(),
+			"litellm_access_group":          resourceAccessGroup(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -142,15 +175,16 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		return nil, diags
 	}
 
-	client := &LitellmClient{}
-	client.ApiBaseURL = apiBaseURL
+	requestTimeout := time.Duration(d.Get("request_timeout").(int)) * time.Second
+	client := litellmapi.NewLitellmClient(apiBaseURL, requestTimeout, d.Get("max_retries").(int))
 
 	switch authenticationMethod {
 	case API_AUTH:
 		client.ApiToken = d.Get("api_token").(string)
 	case JWT_AUTH:
 		jwtInfo := getJwtAuth(d)
-		token, err := jwtauth.GetApiTokenFromJwt(jwtInfo)
+		jwtInfo.HTTPClient = client
+		token, err := jwtauth.GetApiTokenFromJwtWithContext(ctx, jwtInfo)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
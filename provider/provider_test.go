@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,3 +29,57 @@ func TestProviderSchema(t *testing.T) {
 	assert.Contains(t, schema, "api_token")
 	assert.Contains(t, schema, "api_base_url")
 }
+
+// configureTestProvider returns the provider and its configured client, talking to a test server serving mux
+func configureTestProvider(t *testing.T, mux *http.ServeMux) (*schema.Provider, interface{}) {
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	p := NewProvider()
+	providerConfig := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"api_token":    "test-token",
+		"api_base_url": server.URL,
+		"max_retries":  0,
+	})
+
+	meta, diags := p.ConfigureContextFunc(context.Background(), providerConfig)
+	if diags.HasError() {
+		t.Fatalf("Failed to configure provider: %s", diags[0].Summary)
+	}
+	return p, meta
+}
+
+// planUpdate returns the resource data Terraform hands to UpdateContext when config is applied over the state of d.
+// d may be a new resource data, the result is then the one handed to CreateContext. The raw configuration is kept
+// so that write-only attributes can be read.
+func planUpdate(t *testing.T, resource *schema.Resource, d *schema.ResourceData, config map[string]interface{}) *schema.ResourceData {
+	state := d.State()
+	if state == nil {
+		state = &terraform.InstanceState{}
+	}
+	state.RawConfig = rawConfig(t, resource, config)
+
+	diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("Failed to compute diff: %s", err)
+	}
+
+	updated, err := schema.InternalMap(resource.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("Failed to build resource data: %s", err)
+	}
+	return updated
+}
+
+// rawConfig converts config into the cty value Terraform sends as the configuration of the resource
+func rawConfig(t *testing.T, resource *schema.Resource, config map[string]interface{}) cty.Value {
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("Failed to encode config: %s", err)
+	}
+	value, err := ctyjson.Unmarshal(encoded, resource.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("Failed to convert config: %s", err)
+	}
+	return value
+}
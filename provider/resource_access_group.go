@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func resourceAccessGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccessGroupCreate,
+		ReadContext:   resourceAccessGroupRead,
+		UpdateContext: resourceAccessGroupUpdate,
+		DeleteContext: resourceAccessGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the access group.",
+			},
+			"access_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the access group, granted to keys and teams through their `models`.",
+			},
+			"model_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "IDs of the `litellm_model` deployments in the group.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceAccessGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	group := d.Get("access_group").(string)
+	for _, modelID := range expandStringSet(d.Get("model_ids").(*schema.Set)) {
+		if err := setModelAccessGroup(ctx, client, modelID, group, true); err != nil {
+			return diagFromErr(err, resourceAccessGroup().Schema)
+		}
+	}
+
+	d.SetId(group)
+
+	return resourceAccessGroupRead(ctx, d, m)
+}
+
+func resourceAccessGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	models, err := client.ListModels(ctx)
+	if err != nil {
+		return diagFromErr(err, resourceAccessGroup().Schema)
+	}
+
+	var modelIDs []string
+	for _, model := range models {
+		for _, group := range model.AccessGroups() {
+			if group == d.Id() {
+				modelIDs = append(modelIDs, model.ID())
+				break
+			}
+		}
+	}
+	if len(modelIDs) == 0 {
+		// The proxy only knows groups through the models in them, no model left means the group is gone
+		d.SetId("")
+		return diags
+	}
+	sort.Strings(modelIDs)
+
+	if err := d.Set("access_group", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("model_ids", modelIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	if d.HasChange("model_ids") {
+		oldIDs, newIDs := d.GetChange("model_ids")
+		for _, modelID := range expandStringSet(newIDs.(*schema.Set).Difference(oldIDs.(*schema.Set))) {
+			if err := setModelAccessGroup(ctx, client, modelID, d.Id(), true); err != nil {
+				return diagFromErr(err, resourceAccessGroup().Schema)
+			}
+		}
+		for _, modelID := range expandStringSet(oldIDs.(*schema.Set).Difference(newIDs.(*schema.Set))) {
+			if err := setModelAccessGroup(ctx, client, modelID, d.Id(), false); err != nil && !litellmapi.IsNotFound(err) {
+				return diagFromErr(err, resourceAccessGroup().Schema)
+			}
+		}
+	}
+
+	return resourceAccessGroupRead(ctx, d, m)
+}
+
+func resourceAccessGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	for _, modelID := range expandStringSet(d.Get("model_ids").(*schema.Set)) {
+		if err := setModelAccessGroup(ctx, client, modelID, d.Id(), false); err != nil && !litellmapi.IsNotFound(err) {
+			return diagFromErr(err, resourceAccessGroup().Schema)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// modelLocks serializes the changes to the model_info of each model. Terraform applies access groups in
+// parallel, and two groups sharing a model would otherwise overwrite each other's access_groups.
+var modelLocks = &mutexKV{locks: map[string]*sync.Mutex{}}
+
+// mutexKV is a set of mutexes identified by a key, created on first use
+type mutexKV struct {
+	lock  sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, found := m.locks[key]; !found {
+		m.locks[key] = &sync.Mutex{}
+	}
+	return m.locks[key]
+}
+
+func (m *mutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+func (m *mutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+// setModelAccessGroup adds the group to, or removes it from, the model_info.access_groups of the model
+func setModelAccessGroup(ctx context.Context, client *litellmapi.LitellmClient, modelID, group string, member bool) error {
+	modelLocks.Lock(modelID)
+	defer modelLocks.Unlock(modelID)
+
+	model, err := client.GetModel(ctx, modelID)
+	if err != nil {
+		return err
+	}
+
+	groups := make([]string, 0)
+	found := false
+	for _, g := range model.AccessGroups() {
+		if g == group {
+			found = true
+			if !member {
+				continue
+			}
+		}
+		groups = append(groups, g)
+	}
+	if found == member {
+		return nil
+	}
+	if member {
+		groups = append(groups, group)
+	}
+
+	modelInfo := map[string]interface{}{}
+	for k, v := range model.ModelInfo {
+		modelInfo[k] = v
+	}
+	modelInfo["access_groups"] = groups
+
+	return client.UpdateModelInfo(ctx, modelID, modelInfo)
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAccessGroupCRUD(t *testing.T) {
+	models := map[string]map[string]interface{}{
+		"gpt-4o-eu":   {"id": "gpt-4o-eu", "base_model": "gpt-4o"},
+		"gpt-4o-us":   {"id": "gpt-4o-us", "access_groups": []interface{}{"beta"}},
+		"gpt-4o-mini": {"id": "gpt-4o-mini"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/model/info", func(w http.ResponseWriter, r *http.Request) {
+		ids := []string{}
+		if id := r.URL.Query().Get("litellm_model_id"); id != "" {
+			ids = append(ids, id)
+		} else {
+			for id := range models {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+		}
+		data := []interface{}{}
+		for _, id := range ids {
+			data = append(data, map[string]interface{}{
+				"model_name":     id,
+				"litellm_params": map[string]interface{}{"model": "openai/gpt-4o"},
+				"model_info":     models[id],
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	})
+	mux.HandleFunc("/model/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/model/"), "/update")
+		body := map[string]map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, id, body["model_info"]["id"])
+		models[id] = body["model_info"]
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_access_group"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("access_group", "beta")
+	resourceData.Set("model_ids", []interface{}{"gpt-4o-eu", "gpt-4o-us"})
+
+	// Test Create adds the group to the models and keeps their other model_info
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "beta", resourceData.Id())
+	assert.Equal(t, []interface{}{"beta"}, models["gpt-4o-eu"]["access_groups"])
+	assert.Equal(t, "gpt-4o", models["gpt-4o-eu"]["base_model"])
+	assert.Equal(t, []interface{}{"beta"}, models["gpt-4o-us"]["access_groups"])
+
+	// Test Read detects a model added to the group outside of Terraform
+	models["gpt-4o-mini"]["access_groups"] = []interface{}{"beta", "cheap"}
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.ElementsMatch(t, []interface{}{"gpt-4o-eu", "gpt-4o-mini", "gpt-4o-us"}, resourceData.Get("model_ids").(*schema.Set).List())
+
+	// Test Update removes the group from the models left out
+	updated := planUpdate(t, resource, resourceData, map[string]interface{}{
+		"access_group": "beta",
+		"model_ids":    []interface{}{"gpt-4o-eu"},
+	})
+	diags = resource.UpdateContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{}, models["gpt-4o-us"]["access_groups"])
+	assert.Equal(t, []interface{}{"cheap"}, models["gpt-4o-mini"]["access_groups"])
+	assert.Equal(t, []interface{}{"gpt-4o-eu"}, updated.Get("model_ids").(*schema.Set).List())
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", updated.Id())
+	assert.Equal(t, []interface{}{}, models["gpt-4o-eu"]["access_groups"])
+
+	// Test Read removes a group no model belongs to anymore
+	updated.SetId("beta")
+	diags = resource.ReadContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", updated.Id())
+}
+
+func TestResourceAccessGroupSharedModel(t *testing.T) {
+	var lock sync.Mutex
+	modelInfo := map[string]interface{}{"id": "gpt-4o"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/model/info", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		info := modelInfo
+		lock.Unlock()
+		// Leave time for a concurrent update to read the same model_info
+		time.Sleep(20 * time.Millisecond)
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": []interface{}{map[string]interface{}{
+			"model_name":     "gpt-4o",
+			"litellm_params": map[string]interface{}{"model": "openai/gpt-4o"},
+			"model_info":     info,
+		}}})
+	})
+	mux.HandleFunc("/model/", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		lock.Lock()
+		modelInfo = body["model_info"]
+		lock.Unlock()
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_access_group"]
+
+	// Test groups created in parallel on the same model both end up in its access_groups
+	var wg sync.WaitGroup
+	for _, group := range []string{"alpha", "beta"} {
+		resourceData := resource.TestResourceData()
+		resourceData.Set("access_group", group)
+		resourceData.Set("model_ids", []interface{}{"gpt-4o"})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			diags := resource.CreateContext(context.Background(), resourceData, meta)
+			assert.False(t, diags.HasError())
+		}()
+	}
+	wg.Wait()
+
+	assert.ElementsMatch(t, []interface{}{"alpha", "beta"}, modelInfo["access_groups"])
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func resourceBudget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBudgetCreate,
+		ReadContext:   resourceBudgetRead,
+		UpdateContext: resourceBudgetUpdate,
+		DeleteContext: resourceBudgetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The budget_id of the budget.",
+			},
+			"budget_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the budget, used to attach it to customers and members. Generated by the proxy when omitted.",
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum spend in USD. Requests are rejected once it is reached.",
+			},
+			"soft_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Spend in USD after which alerts are sent, requests are still allowed.",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Period after which the spend is reset, e.g. `30s`, `30m`, `30h`, `30d`.",
+			},
+			"tpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Tokens per minute limit.",
+			},
+			"rpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Requests per minute limit.",
+			},
+			"model_max_budget": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Budget and limits applying to a single model.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"model": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the model the budget applies to.",
+						},
+						"max_budget": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "Maximum spend in USD on the model.",
+						},
+						"budget_duration": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Period after which the spend on the model is reset, e.g. `30s`, `30m`, `30h`, `30d`.",
+						},
+						"tpm_limit": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Tokens per minute limit on the model.",
+						},
+						"rpm_limit": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Requests per minute limit on the model.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBudgetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	budget := expandBudget(d)
+	budget.BudgetID = d.Get("budget_id").(string)
+
+	created, err := client.CreateBudget(ctx, budget)
+	if err != nil {
+		return diagFromErr(err, resourceBudget().Schema)
+	}
+
+	d.SetId(created.BudgetID)
+
+	return resourceBudgetRead(ctx, d, m)
+}
+
+func resourceBudgetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	budget, err := client.GetBudget(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The budget was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceBudget().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"budget_id":        budget.BudgetID,
+		"max_budget":       derefFloat(budget.MaxBudget),
+		"soft_budget":      derefFloat(budget.SoftBudget),
+		"budget_duration":  budget.BudgetDuration,
+		"tpm_limit":        derefInt(budget.TpmLimit),
+		"rpm_limit":        derefInt(budget.RpmLimit),
+		"model_max_budget": flattenModelMaxBudget(budget.ModelMaxBudget),
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceBudgetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	budget := expandBudget(d)
+	budget.BudgetID = d.Id()
+
+	cleared := clearedAttributes(d, "max_budget", "soft_budget", "budget_duration", "tpm_limit", "rpm_limit", "model_max_budget")
+	if err := client.UpdateBudget(ctx, budget, cleared...); err != nil {
+		return diagFromErr(err, resourceBudget().Schema)
+	}
+
+	return resourceBudgetRead(ctx, d, m)
+}
+
+func resourceBudgetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteBudget(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceBudget().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandBudget builds the API representation of the budget from the resource configuration
+func expandBudget(d *schema.ResourceData) *litellmapi.Budget {
+	budget := &litellmapi.Budget{
+		MaxBudget:      optionalFloat(d, "max_budget"),
+		SoftBudget:     optionalFloat(d, "soft_budget"),
+		BudgetDuration: d.Get("budget_duration").(string),
+		TpmLimit:       optionalInt(d, "tpm_limit"),
+		RpmLimit:       optionalInt(d, "rpm_limit"),
+	}
+
+	modelBudgets := d.Get("model_max_budget").(*schema.Set).List()
+	if len(modelBudgets) > 0 {
+		budget.ModelMaxBudget = make(map[string]litellmapi.ModelBudget)
+	}
+	for _, v := range modelBudgets {
+		modelBudget := v.(map[string]interface{})
+		budget.ModelMaxBudget[modelBudget["model"].(string)] = litellmapi.ModelBudget{
+			MaxBudget:      nonZeroFloat(modelBudget["max_budget"].(float64)),
+			BudgetDuration: modelBudget["budget_duration"].(string),
+			TpmLimit:       nonZeroInt(modelBudget["tpm_limit"].(int)),
+			RpmLimit:       nonZeroInt(modelBudget["rpm_limit"].(int)),
+		}
+	}
+	return budget
+}
+
+func flattenModelMaxBudget(modelMaxBudget map[string]litellmapi.ModelBudget) []interface{} {
+	result := make([]interface{}, 0, len(modelMaxBudget))
+	for model, modelBudget := range modelMaxBudget {
+		result = append(result, map[string]interface{}{
+			"model":           model,
+			"max_budget":      derefFloat(modelBudget.MaxBudget),
+			"budget_duration": modelBudget.BudgetDuration,
+			"tpm_limit":       derefInt(modelBudget.TpmLimit),
+			"rpm_limit":       derefInt(modelBudget.RpmLimit),
+		})
+	}
+	return result
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceBudgetCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/budget/new", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		assert.Equal(t, "gold", stored["budget_id"])
+		json.NewEncoder(w).Encode(stored)
+	})
+	mux.HandleFunc("/budget/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body := map[string][]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"gold"}, body["budgets"])
+		if stored == nil {
+			w.Write([]byte(`[]`))
+			return
+		}
+		json.NewEncoder(w).Encode([]interface{}{stored})
+	})
+	mux.HandleFunc("/budget/update", func(w http.ResponseWriter, r *http.Request) {
+		update := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+		assert.Equal(t, "gold", update["budget_id"])
+		stored = update
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/budget/delete", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "gold", body["id"])
+		stored = nil
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_budget"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("budget_id", "gold")
+	resourceData.Set("max_budget", 100.0)
+	resourceData.Set("soft_budget", 80.0)
+	resourceData.Set("budget_duration", "30d")
+	resourceData.Set("model_max_budget", []interface{}{
+		map[string]interface{}{"model": "gpt-4o", "max_budget": 50.0, "budget_duration": "1d"},
+	})
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "gold", resourceData.Id())
+	assert.Equal(t, map[string]interface{}{"gpt-4o": map[string]interface{}{"max_budget": 50.0, "budget_duration": "1d"}}, stored["model_max_budget"])
+	assert.Equal(t, 80.0, resourceData.Get("soft_budget"))
+	assert.Equal(t, 1, resourceData.Get("model_max_budget.#"))
+
+	// Test Update
+	resourceData.Set("model_max_budget", []interface{}{
+		map[string]interface{}{"model": "gpt-4o", "max_budget": 50.0},
+		map[string]interface{}{"model": "gpt-4o-mini", "tpm_limit": 1000},
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, resourceData.Get("model_max_budget.#"))
+	assert.Equal(t, map[string]interface{}{"tpm_limit": 1000.0}, stored["model_max_budget"].(map[string]interface{})["gpt-4o-mini"])
+
+	// Test Update clears the limits removed from the configuration
+	resourceData = planUpdate(t, resource, resourceData, map[string]interface{}{
+		"budget_id":       "gold",
+		"max_budget":      100.0,
+		"budget_duration": "30d",
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, stored, "soft_budget")
+	assert.Nil(t, stored["soft_budget"])
+	assert.Contains(t, stored, "model_max_budget")
+	assert.Nil(t, stored["model_max_budget"])
+	assert.Equal(t, 0.0, resourceData.Get("soft_budget"))
+	assert.Equal(t, 0, resourceData.Get("model_max_budget.#"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a budget deleted outside of Terraform
+	resourceData.SetId("gold")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func resourceCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCredentialCreate,
+		ReadContext:   resourceCredentialRead,
+		UpdateContext: resourceCredentialUpdate,
+		DeleteContext: resourceCredentialDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The credential_name of the credential.",
+			},
+			"credential_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the credential, referenced by `litellm_credential_name` in `litellm_model`.",
+			},
+			"credential_values": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				ExactlyOneOf:     []string{"credential_values", "credential_values_wo"},
+				ValidateDiagFunc: validateSecretMap,
+				Description:      "Secret values of the credential, e.g. `api_key` or `api_base`. The proxy never returns them, so changes made outside of Terraform are not detected.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"credential_values_wo": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				WriteOnly:        true,
+				ExactlyOneOf:     []string{"credential_values", "credential_values_wo"},
+				ValidateDiagFunc: validateSecretJSON,
+				Description:      "Write-only JSON object of the secret values of the credential, sent when the credential is created or updated but never stored in the state. Requires Terraform 1.11 or later, bump `credential_values_wo_version` to send new values.",
+			},
+			"credential_values_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of `credential_values_wo`. Terraform cannot detect changes of write-only attributes, change it to update the credential with their current values.",
+			},
+			"credential_info": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Non secret information about the credential, e.g. `custom_llm_provider`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	credential, err := expandCredential(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := client.CreateCredential(ctx, credential); err != nil {
+		return diagFromErr(err, resourceCredential().Schema)
+	}
+
+	d.SetId(credential.CredentialName)
+
+	return resourceCredentialRead(ctx, d, m)
+}
+
+func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	credential, err := client.GetCredential(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The credential was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceCredential().Schema)
+	}
+
+	// credential_values are left untouched, the proxy only returns them masked if at all
+	if err := d.Set("credential_name", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	credentialInfo := flattenStringMap(credential.CredentialInfo, d.Get("credential_info").(map[string]interface{}))
+	if err := d.Set("credential_info", credentialInfo); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	credential, err := expandCredential(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := client.UpdateCredential(ctx, credential); err != nil {
+		return diagFromErr(err, resourceCredential().Schema)
+	}
+
+	return resourceCredentialRead(ctx, d, m)
+}
+
+func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteCredential(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceCredential().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandCredential builds the API representation of the credential from the resource configuration
+func expandCredential(d *schema.ResourceData) (*litellmapi.Credential, error) {
+	credentialValues := d.Get("credential_values").(map[string]interface{})
+	if len(credentialValues) == 0 {
+		writeOnlyValues, err := writeOnlySecrets(d, cty.GetAttrPath("credential_values_wo"))
+		if err != nil {
+			return nil, err
+		}
+		credentialValues = writeOnlyValues
+	}
+
+	return &litellmapi.Credential{
+		CredentialName:   d.Get("credential_name").(string),
+		CredentialValues: credentialValues,
+		CredentialInfo:   d.Get("credential_info").(map[string]interface{}),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceCredentialCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/credentials", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		w.Write([]byte(`{"success": true}`))
+	})
+	mux.HandleFunc("/credentials/by_name/azure-prod", func(w http.ResponseWriter, r *http.Request) {
+		if stored == nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Credential not found"}`))
+			return
+		}
+		// The proxy never returns the secret values
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"credential_name":   stored["credential_name"],
+			"credential_values": map[string]interface{}{},
+			"credential_info":   stored["credential_info"],
+		})
+	})
+	mux.HandleFunc("/credentials/azure-prod", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		case http.MethodDelete:
+			stored = nil
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		w.Write([]byte(`{"success": true}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_credential"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("credential_name", "azure-prod")
+	resourceData.Set("credential_values", map[string]interface{}{"api_key": "sk-azure", "api_base": "https://example.openai.azure.com"})
+	resourceData.Set("credential_info", map[string]interface{}{"custom_llm_provider": "azure"})
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "azure-prod", resourceData.Id())
+	assert.Equal(t, map[string]interface{}{"api_key": "sk-azure", "api_base": "https://example.openai.azure.com"}, stored["credential_values"])
+	assert.Equal(t, map[string]interface{}{"api_key": "sk-azure", "api_base": "https://example.openai.azure.com"}, resourceData.Get("credential_values"))
+
+	// Test Read detects drift of credential_info
+	stored["credential_info"] = map[string]interface{}{"custom_llm_provider": "openai"}
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, map[string]interface{}{"custom_llm_provider": "openai"}, resourceData.Get("credential_info"))
+
+	// Test Update
+	resourceData.Set("credential_values", map[string]interface{}{"api_key": "sk-rotated", "api_base": "https://example.openai.azure.com"})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "sk-rotated", stored["credential_values"].(map[string]interface{})["api_key"])
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a credential deleted outside of Terraform
+	resourceData.SetId("azure-prod")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
+
+func TestResourceCredentialWriteOnlyValues(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/credentials", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		w.Write([]byte(`{"success": true}`))
+	})
+	mux.HandleFunc("/credentials/by_name/azure-prod", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"credential_name": "azure-prod", "credential_values": map[string]interface{}{}})
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_credential"]
+
+	resourceData := planUpdate(t, resource, resource.TestResourceData(), map[string]interface{}{
+		"credential_name":              "azure-prod",
+		"credential_values_wo":         `{"api_key": "os.environ/AZURE_API_KEY", "api_base": "https://example.openai.azure.com"}`,
+		"credential_values_wo_version": 1,
+	})
+
+	// Test Create sends the write-only values
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, map[string]interface{}{"api_key": "os.environ/AZURE_API_KEY", "api_base": "https://example.openai.azure.com"}, stored["credential_values"])
+	assert.Empty(t, resourceData.Get("credential_values"))
+	assert.Equal(t, 1, resourceData.Get("credential_values_wo_version"))
+
+	// Test both values are rejected
+	diags = resource.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"credential_name":      "azure-prod",
+		"credential_values":    map[string]interface{}{"api_key": "sk-azure"},
+		"credential_values_wo": `{"api_key": "sk-azure"}`,
+	}))
+	assert.True(t, diags.HasError())
+}
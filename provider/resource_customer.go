@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func resourceCustomer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomerCreate,
+		ReadContext:   resourceCustomerRead,
+		UpdateContext: resourceCustomerUpdate,
+		DeleteContext: resourceCustomerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user_id of the customer.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the customer, as sent in the `user` field of the requests made to the proxy.",
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User-friendly name of the customer.",
+			},
+			"blocked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the requests of the customer are rejected.",
+			},
+			"max_budget": {
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				ConflictsWith: []string{"budget_id"},
+				Description:   "Maximum spend in USD for the customer. Conflicts with `budget_id`.",
+			},
+			"budget_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"max_budget"},
+				Description:   "ID of a `litellm_budget` applying to the customer. Conflicts with `max_budget`.",
+			},
+			"allowed_model_region": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"eu", "us"}, false)),
+				Description:      "Region the requests of the customer are restricted to, either `eu` or `us`.",
+			},
+			"default_model": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Model used for the requests of the customer when the requested model is not available in `allowed_model_region`.",
+			},
+		},
+	}
+}
+
+func resourceCustomerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	customer := expandCustomer(d)
+	if err := client.CreateCustomer(ctx, customer); err != nil {
+		return diagFromErr(err, resourceCustomer().Schema)
+	}
+
+	d.SetId(customer.UserID)
+
+	return resourceCustomerRead(ctx, d, m)
+}
+
+func resourceCustomerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	customer, err := client.GetCustomer(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The customer was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceCustomer().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"user_id":              d.Id(),
+		"alias":                customer.Alias,
+		"blocked":              customer.Blocked,
+		"budget_id":            customer.BudgetID,
+		"allowed_model_region": customer.AllowedModelRegion,
+		"default_model":        customer.DefaultModel,
+	}
+	// With budget_id, the budget table returned by the proxy is the one of the referenced budget
+	if _, ok := d.GetOk("budget_id"); !ok {
+		attributes["max_budget"] = derefFloat(customer.MaxBudget)
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceCustomerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	cleared := clearedAttributes(d, "alias", "max_budget", "budget_id", "allowed_model_region", "default_model")
+	if err := client.UpdateCustomer(ctx, expandCustomer(d), cleared...); err != nil {
+		return diagFromErr(err, resourceCustomer().Schema)
+	}
+
+	if d.HasChange("blocked") {
+		if err := client.SetCustomerBlocked(ctx, d.Id(), d.Get("blocked").(bool)); err != nil {
+			return diagFromErr(err, resourceCustomer().Schema)
+		}
+	}
+
+	return resourceCustomerRead(ctx, d, m)
+}
+
+func resourceCustomerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteCustomer(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceCustomer().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandCustomer builds the API representation of the customer from the resource configuration
+func expandCustomer(d *schema.ResourceData) *litellmapi.Customer {
+	return &litellmapi.Customer{
+		UserID:             d.Get("user_id").(string),
+		Alias:              d.Get("alias").(string),
+		Blocked:            d.Get("blocked").(bool),
+		MaxBudget:          optionalFloat(d, "max_budget"),
+		BudgetID:           d.Get("budget_id").(string),
+		AllowedModelRegion: d.Get("allowed_model_region").(string),
+		DefaultModel:       d.Get("default_model").(string),
+	}
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceCustomerCRUD(t *testing.T) {
+	var stored map[string]interface{}
+	var blockCalls []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/customer/new", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/customer/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "customer-1", r.URL.Query().Get("end_user_id"))
+		if stored == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		response := map[string]interface{}{}
+		for k, v := range stored {
+			response[k] = v
+		}
+		response["litellm_budget_table"] = map[string]interface{}{"max_budget": stored["max_budget"]}
+		delete(response, "max_budget")
+		json.NewEncoder(w).Encode(response)
+	})
+	mux.HandleFunc("/customer/update", func(w http.ResponseWriter, r *http.Request) {
+		update := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+		for k, v := range update {
+			if k != "blocked" {
+				stored[k] = v
+			}
+		}
+		w.Write([]byte(`{}`))
+	})
+	block := func(blocked bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			body := map[string][]string{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []string{"customer-1"}, body["user_ids"])
+			blockCalls = append(blockCalls, r.URL.Path)
+			stored["blocked"] = blocked
+			w.Write([]byte(`{}`))
+		}
+	}
+	mux.HandleFunc("/customer/block", block(true))
+	mux.HandleFunc("/customer/unblock", block(false))
+	mux.HandleFunc("/customer/delete", func(w http.ResponseWriter, r *http.Request) {
+		stored = nil
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_customer"]
+
+	config := map[string]interface{}{
+		"user_id":              "customer-1",
+		"alias":                "ACME",
+		"max_budget":           20.0,
+		"allowed_model_region": "eu",
+	}
+	resourceData := resource.TestResourceData()
+	for k, v := range config {
+		resourceData.Set(k, v)
+	}
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "customer-1", resourceData.Id())
+	assert.Equal(t, 20.0, resourceData.Get("max_budget"))
+	assert.Equal(t, false, resourceData.Get("blocked"))
+
+	// Test Read detects a customer blocked outside of Terraform
+	stored["blocked"] = true
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, true, resourceData.Get("blocked"))
+
+	// Test Update unblocks the customer
+	resourceData = planUpdate(t, resource, resourceData, config)
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"/customer/unblock"}, blockCalls)
+	assert.Equal(t, false, resourceData.Get("blocked"))
+
+	// Test Update clears the settings removed from the configuration
+	resourceData = planUpdate(t, resource, resourceData, map[string]interface{}{
+		"user_id": "customer-1",
+		"alias":   "ACME",
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, stored, "max_budget")
+	assert.Nil(t, stored["max_budget"])
+	assert.Nil(t, stored["allowed_model_region"])
+	assert.Equal(t, 0.0, resourceData.Get("max_budget"))
+	assert.Equal(t, "", resourceData.Get("allowed_model_region"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a customer deleted outside of Terraform
+	resourceData.SetId("customer-1")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+var guardrailModes = []string{"pre_call", "post_call", "during_call", "logging_only"}
+
+func resourceGuardrail() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGuardrailCreate,
+		ReadContext:   resourceGuardrailRead,
+		UpdateContext: resourceGuardrailUpdate,
+		DeleteContext: resourceGuardrailDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The guardrail_id of the guardrail.",
+			},
+			"guardrail_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the guardrail, used by clients and keys to enable it.",
+			},
+			"guardrail": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Integration implementing the guardrail, e.g. `presidio`, `lakera`, `aporia`, `bedrock` or a custom guardrail such as `custom_guardrail.MyGuardrail`.",
+			},
+			"mode": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(guardrailModes, false)),
+				Description:      "When the guardrail runs, one of `pre_call`, `post_call`, `during_call` or `logging_only`.",
+			},
+			"default_on": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the guardrail runs on every request, without clients having to enable it.",
+			},
+			"guardrail_info": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional information about the guardrail, e.g. a `description`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"litellm_params": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Settings of the integration. Only the ones relevant to `guardrail` need to be set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							ValidateDiagFunc: validateSecret,
+							Description:      "API key of the guardrail service. The proxy only returns it masked, so changes made outside of Terraform are not detected.",
+						},
+						"api_key_wo": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							WriteOnly:        true,
+							ValidateDiagFunc: validateSecret,
+							ConflictsWith:    []string{"litellm_params.0.api_key"},
+							Description:      "Write-only API key of the guardrail service, sent when the guardrail is created or updated but never stored in the state. Requires Terraform 1.11 or later, bump `api_key_wo_version` to send a new value.",
+						},
+						"api_key_wo_version": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Version of `api_key_wo`. Terraform cannot detect changes of write-only attributes, change it to update the guardrail with its current value.",
+						},
+						"api_base": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Base URL of the guardrail service.",
+						},
+						"guardrail_identifier": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the Bedrock guardrail.",
+						},
+						"guardrail_version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Version of the Bedrock guardrail, e.g. `DRAFT` or `1`.",
+						},
+						"aws_region_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "AWS region of the Bedrock guardrail.",
+						},
+						"mask_request_content": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the content flagged in requests is masked instead of blocked.",
+						},
+						"mask_response_content": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the content flagged in responses is masked instead of blocked.",
+						},
+						"output_parse_pii": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the PII masked by Presidio is restored in the response.",
+						},
+						"presidio_analyzer_api_base": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Base URL of the Presidio analyzer.",
+						},
+						"presidio_anonymizer_api_base": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Base URL of the Presidio anonymizer.",
+						},
+						"pii_entities_config": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Action taken by Presidio for each PII entity type, e.g. `CREDIT_CARD = \"BLOCK\"` or `EMAIL_ADDRESS = \"MASK\"`.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGuardrailCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	created, err := client.CreateGuardrail(ctx, expandGuardrail(d))
+	if err != nil {
+		return diagFromErr(err, resourceGuardrail().Schema)
+	}
+
+	d.SetId(created.GuardrailID)
+
+	return resourceGuardrailRead(ctx, d, m)
+}
+
+func resourceGuardrailRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	guardrail, err := client.GetGuardrail(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The guardrail was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceGuardrail().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"guardrail_name": guardrail.GuardrailName,
+		"guardrail":      guardrail.LitellmParams.Guardrail,
+		"mode":           guardrail.LitellmParams.Mode,
+		"default_on":     guardrail.LitellmParams.DefaultOn,
+		"guardrail_info": flattenStringMap(guardrail.GuardrailInfo, d.Get("guardrail_info").(map[string]interface{})),
+		"litellm_params": flattenGuardrailParams(&guardrail.LitellmParams, d.Get("litellm_params").([]interface{})),
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceGuardrailUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	guardrail := expandGuardrail(d)
+	guardrail.GuardrailID = d.Id()
+
+	if err := client.UpdateGuardrail(ctx, guardrail); err != nil {
+		return diagFromErr(err, resourceGuardrail().Schema)
+	}
+
+	return resourceGuardrailRead(ctx, d, m)
+}
+
+func resourceGuardrailDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteGuardrail(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceGuardrail().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandGuardrail builds the API representation of the guardrail from the resource configuration
+func expandGuardrail(d *schema.ResourceData) *litellmapi.Guardrail {
+	guardrail := &litellmapi.Guardrail{
+		GuardrailName: d.Get("guardrail_name").(string),
+		LitellmParams: litellmapi.GuardrailParams{
+			Guardrail: d.Get("guardrail").(string),
+			Mode:      d.Get("mode").(string),
+			DefaultOn: d.Get("default_on").(bool),
+		},
+		GuardrailInfo: d.Get("guardrail_info").(map[string]interface{}),
+	}
+
+	params := d.Get("litellm_params").([]interface{})
+	if len(params) == 0 || params[0] == nil {
+		return guardrail
+	}
+	p := params[0].(map[string]interface{})
+	guardrail.LitellmParams.APIKey = p["api_key"].(string)
+	if apiKey := writeOnlyString(d, cty.GetAttrPath("litellm_params").IndexInt(0).GetAttr("api_key_wo")); apiKey != "" {
+		guardrail.LitellmParams.APIKey = apiKey
+	}
+	guardrail.LitellmParams.APIBase = p["api_base"].(string)
+	guardrail.LitellmParams.GuardrailIdentifier = p["guardrail_identifier"].(string)
+	guardrail.LitellmParams.GuardrailVersion = p["guardrail_version"].(string)
+	guardrail.LitellmParams.AWSRegionName = p["aws_region_name"].(string)
+	guardrail.LitellmParams.MaskRequestContent = p["mask_request_content"].(bool)
+	guardrail.LitellmParams.MaskResponseContent = p["mask_response_content"].(bool)
+	guardrail.LitellmParams.OutputParsePII = p["output_parse_pii"].(bool)
+	guardrail.LitellmParams.PresidioAnalyzerAPIBase = p["presidio_analyzer_api_base"].(string)
+	guardrail.LitellmParams.PresidioAnonymizerAPIBase = p["presidio_anonymizer_api_base"].(string)
+	guardrail.LitellmParams.PIIEntitiesConfig = p["pii_entities_config"].(map[string]interface{})
+	return guardrail
+}
+
+// flattenGuardrailParams refreshes the litellm_params block. The proxy masks api_key, so the value from the state is kept,
+// api_key_wo is never stored.
+func flattenGuardrailParams(params *litellmapi.GuardrailParams, current []interface{}) []interface{} {
+	var state map[string]interface{}
+	if len(current) > 0 && current[0] != nil {
+		state = current[0].(map[string]interface{})
+	}
+
+	var apiKey string
+	var apiKeyVersion int
+	currentPIIEntitiesConfig := map[string]interface{}{}
+	if state != nil {
+		apiKey = state["api_key"].(string)
+		apiKeyVersion = state["api_key_wo_version"].(int)
+		currentPIIEntitiesConfig = state["pii_entities_config"].(map[string]interface{})
+	}
+
+	block := map[string]interface{}{
+		"api_key":                      apiKey,
+		"api_key_wo_version":           apiKeyVersion,
+		"api_base":                     params.APIBase,
+		"guardrail_identifier":         params.GuardrailIdentifier,
+		"guardrail_version":            params.GuardrailVersion,
+		"aws_region_name":              params.AWSRegionName,
+		"mask_request_content":         params.MaskRequestContent,
+		"mask_response_content":        params.MaskResponseContent,
+		"output_parse_pii":             params.OutputParsePII,
+		"presidio_analyzer_api_base":   params.PresidioAnalyzerAPIBase,
+		"presidio_anonymizer_api_base": params.PresidioAnonymizerAPIBase,
+		"pii_entities_config":          flattenStringMap(params.PIIEntitiesConfig, currentPIIEntitiesConfig),
+	}
+
+	// Leave the block out of the state when it is not configured and the proxy has none of its settings
+	if state == nil && isZeroGuardrailParams(params) {
+		return nil
+	}
+	return []interface{}{block}
+}
+
+func isZeroGuardrailParams(params *litellmapi.GuardrailParams) bool {
+	return params.APIBase == "" && params.GuardrailIdentifier == "" && params.GuardrailVersion == "" &&
+		params.AWSRegionName == "" && !params.MaskRequestContent && !params.MaskResponseContent &&
+		!params.OutputParsePII && params.PresidioAnalyzerAPIBase == "" && params.PresidioAnonymizerAPIBase == "" &&
+		len(params.PIIEntitiesConfig) == 0
+}
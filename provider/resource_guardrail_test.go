@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceGuardrailCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/guardrails", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		request := map[string]map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		stored = request["guardrail"]
+		stored["guardrail_id"] = "guardrail-1"
+		json.NewEncoder(w).Encode(stored)
+	})
+	mux.HandleFunc("/guardrails/guardrail-1/info", func(w http.ResponseWriter, r *http.Request) {
+		if stored == nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Guardrail with ID guardrail-1 not found"}`))
+			return
+		}
+		// The proxy masks the api_key of the guardrail
+		response := map[string]interface{}{}
+		for k, v := range stored {
+			response[k] = v
+		}
+		params := map[string]interface{}{}
+		for k, v := range stored["litellm_params"].(map[string]interface{}) {
+			params[k] = v
+		}
+		params["api_key"] = "sk-****"
+		response["litellm_params"] = params
+		json.NewEncoder(w).Encode(response)
+	})
+	mux.HandleFunc("/guardrails/guardrail-1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			request := map[string]map[string]interface{}{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			stored = request["guardrail"]
+		case http.MethodDelete:
+			stored = nil
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_guardrail"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("guardrail_name", "pii-masking")
+	resourceData.Set("guardrail", "presidio")
+	resourceData.Set("mode", "pre_call")
+	resourceData.Set("litellm_params", []interface{}{map[string]interface{}{
+		"api_key":                    "sk-presidio",
+		"presidio_analyzer_api_base": "http://presidio-analyzer:3000",
+		"pii_entities_config":        map[string]interface{}{"CREDIT_CARD": "BLOCK"},
+	}})
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "guardrail-1", resourceData.Id())
+	params := stored["litellm_params"].(map[string]interface{})
+	assert.Equal(t, "presidio", params["guardrail"])
+	assert.Equal(t, "pre_call", params["mode"])
+	assert.Equal(t, false, params["default_on"])
+	assert.Equal(t, "sk-presidio", params["api_key"])
+	assert.NotContains(t, params, "guardrailIdentifier")
+	assert.Equal(t, "sk-presidio", resourceData.Get("litellm_params.0.api_key"))
+
+	// Test Read refreshes the server-side definition
+	params["mode"] = "post_call"
+	params["pii_entities_config"] = map[string]interface{}{"CREDIT_CARD": "MASK"}
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "post_call", resourceData.Get("mode"))
+	assert.Equal(t, map[string]interface{}{"CREDIT_CARD": "MASK"}, resourceData.Get("litellm_params.0.pii_entities_config"))
+	assert.Equal(t, "sk-presidio", resourceData.Get("litellm_params.0.api_key"))
+
+	// Test Update
+	resourceData.Set("mode", "pre_call")
+	resourceData.Set("default_on", true)
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, true, stored["litellm_params"].(map[string]interface{})["default_on"])
+	assert.Equal(t, "pre_call", stored["litellm_params"].(map[string]interface{})["mode"])
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a guardrail deleted outside of Terraform
+	resourceData.SetId("guardrail-1")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
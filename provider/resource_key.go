@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+// hashedTokenPattern matches the sha256 hashed tokens the proxy uses to identify keys
+var hashedTokenPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+func resourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeyCreate,
+		ReadContext:   resourceKeyRead,
+		UpdateContext: resourceKeyUpdate,
+		DeleteContext: resourceKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hashed token identifying the key.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated virtual API key. Only known when the key is created by Terraform, it is empty for imported keys.",
+			},
+			"key_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User-friendly name of the key.",
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Model names the key can call. An empty list gives access to every model.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum spend in USD for the key.",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Period after which the spend of the key is reset, e.g. `30s`, `30m`, `30h`, `30d`.",
+			},
+			"tpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Tokens per minute limit of the key.",
+			},
+			"rpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Requests per minute limit of the key.",
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Team the key belongs to.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User the key belongs to.",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Metadata attached to the key.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "How long the key is valid, e.g. `30s`, `30m`, `30h`, `30d`. The key never expires when omitted. Changing it extends the key from the time of the update.",
+			},
+			"aliases": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Model aliases for the key, mapping the name used by clients to a model name of the proxy.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"expires": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the key, empty when it never expires.",
+			},
+		},
+	}
+}
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	key := expandKey(d)
+	key.Duration = d.Get("duration").(string)
+
+	generated, err := client.GenerateKey(ctx, key)
+	if err != nil {
+		return diagFromErr(err, resourceKey().Schema)
+	}
+
+	d.SetId(generated.HashedToken())
+	if err := d.Set("key", generated.Key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeyRead(ctx, d, m)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	key, err := client.GetKey(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The key was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceKey().Schema)
+	}
+
+	if err := setKeyState(d, key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	key := expandKey(d)
+	key.Key = d.Id()
+	if d.HasChange("duration") {
+		key.Duration = d.Get("duration").(string)
+	}
+
+	cleared := clearedAttributes(d, "key_alias", "max_budget", "budget_duration", "tpm_limit", "rpm_limit",
+		"team_id", "user_id", "metadata", "aliases")
+	if err := client.UpdateKey(ctx, key, cleared...); err != nil {
+		return diagFromErr(err, resourceKey().Schema)
+	}
+
+	return resourceKeyRead(ctx, d, m)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteKey(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceKey().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceKeyImport accepts either the hashed token of the key or its key_alias
+func resourceKeyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*litellmapi.LitellmClient)
+
+	if hashedTokenPattern.MatchString(d.Id()) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	key, err := client.GetKeyByAlias(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(key.HashedToken())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandKey builds the API representation of the key from the resource configuration
+func expandKey(d *schema.ResourceData) *litellmapi.Key {
+	return &litellmapi.Key{
+		KeyAlias:       d.Get("key_alias").(string),
+		Models:         expandStringList(d.Get("models").([]interface{})),
+		MaxBudget:      optionalFloat(d, "max_budget"),
+		BudgetDuration: d.Get("budget_duration").(string),
+		TpmLimit:       optionalInt(d, "tpm_limit"),
+		RpmLimit:       optionalInt(d, "rpm_limit"),
+		TeamID:         d.Get("team_id").(string),
+		UserID:         d.Get("user_id").(string),
+		Metadata:       d.Get("metadata").(map[string]interface{}),
+		Aliases:        d.Get("aliases").(map[string]interface{}),
+	}
+}
+
+// setKeyState refreshes the key attributes from the key returned by the API
+func setKeyState(d *schema.ResourceData, key *litellmapi.Key) error {
+	attributes := map[string]interface{}{
+		"key_alias":       key.KeyAlias,
+		"models":          key.Models,
+		"max_budget":      derefFloat(key.MaxBudget),
+		"budget_duration": key.BudgetDuration,
+		"tpm_limit":       derefInt(key.TpmLimit),
+		"rpm_limit":       derefInt(key.RpmLimit),
+		"team_id":         key.TeamID,
+		"user_id":         key.UserID,
+		"metadata":        flattenStringMap(key.Metadata, d.Get("metadata").(map[string]interface{})),
+		"aliases":         flattenStringMap(key.Aliases, d.Get("aliases").(map[string]interface{})),
+		"expires":         key.Expires,
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
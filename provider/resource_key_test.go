@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testHashedToken = "88dc28d0f030c55ed4ab77ed8faf098196cb1c05df778539800c9f1243fe6b4b"
+
+func TestResourceKeyCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/key/generate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		assert.Equal(t, "30d", stored["duration"])
+		stored["token"] = testHashedToken
+		stored["expires"] = "2026-11-17T00:00:00Z"
+
+		w.Write([]byte(`{"key": "sk-generated", "token": "` + testHashedToken + `", "key_alias": "ci-key"}`))
+	})
+	mux.HandleFunc("/key/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testHashedToken, r.URL.Query().Get("key"))
+		if stored == nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": {"error": "Key not found"}}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"key": testHashedToken, "info": stored})
+	})
+	mux.HandleFunc("/key/update", func(w http.ResponseWriter, r *http.Request) {
+		update := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+		assert.Equal(t, testHashedToken, update["key"])
+		assert.NotContains(t, update, "duration")
+		for k, v := range update {
+			if k != "key" {
+				stored[k] = v
+			}
+		}
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/key/delete", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string][]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{testHashedToken}, body["keys"])
+		stored = nil
+		w.Write([]byte(`{"deleted_keys": ["` + testHashedToken + `"]}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_key"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("key_alias", "ci-key")
+	resourceData.Set("models", []interface{}{"gpt-4", "gpt-4o"})
+	resourceData.Set("max_budget", 100.5)
+	resourceData.Set("budget_duration", "30d")
+	resourceData.Set("tpm_limit", 1000)
+	resourceData.Set("team_id", "team-a")
+	resourceData.Set("user_id", "user-a")
+	resourceData.Set("metadata", map[string]interface{}{"owner": "platform"})
+	resourceData.Set("aliases", map[string]interface{}{"gpt-4": "gpt-4o"})
+	resourceData.Set("duration", "30d")
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, testHashedToken, resourceData.Id())
+	assert.Equal(t, "sk-generated", resourceData.Get("key"))
+	assert.Equal(t, []interface{}{"gpt-4", "gpt-4o"}, resourceData.Get("models"))
+	assert.Equal(t, 100.5, resourceData.Get("max_budget"))
+	assert.Equal(t, 1000, resourceData.Get("tpm_limit"))
+	assert.Equal(t, "2026-11-17T00:00:00Z", resourceData.Get("expires"))
+	assert.NotContains(t, stored, "rpm_limit")
+
+	// Test Read detects drift
+	stored["max_budget"] = 50
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 50.0, resourceData.Get("max_budget"))
+
+	// Test Update
+	resourceData.Set("rpm_limit", 60)
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, float64(60), stored["rpm_limit"])
+	assert.Equal(t, "sk-generated", resourceData.Get("key"))
+
+	// Test Update clears the attributes removed from the configuration
+	updated := planUpdate(t, resource, resourceData, map[string]interface{}{
+		"key_alias": "ci-key",
+		"models":    []interface{}{"gpt-4", "gpt-4o"},
+		"tpm_limit": 1000,
+		"rpm_limit": 60,
+		"team_id":   "team-a",
+		"user_id":   "user-a",
+		"metadata":  map[string]interface{}{"owner": "platform"},
+		"aliases":   map[string]interface{}{"gpt-4": "gpt-4o"},
+		"duration":  "30d",
+	})
+	diags = resource.UpdateContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, stored, "max_budget")
+	assert.Nil(t, stored["max_budget"])
+	assert.Nil(t, stored["budget_duration"])
+	assert.Equal(t, float64(1000), stored["tpm_limit"])
+	assert.Equal(t, 0.0, updated.Get("max_budget"))
+
+	// Test Update clears the ownership and maps removed from the configuration
+	updated = planUpdate(t, resource, updated, map[string]interface{}{
+		"key_alias": "ci-key",
+		"models":    []interface{}{"gpt-4", "gpt-4o"},
+		"tpm_limit": 1000,
+		"rpm_limit": 60,
+		"duration":  "30d",
+	})
+	diags = resource.UpdateContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	for _, name := range []string{"team_id", "user_id", "metadata", "aliases"} {
+		assert.Contains(t, stored, name)
+		assert.Nil(t, stored[name], name)
+	}
+	assert.Equal(t, "", updated.Get("team_id"))
+	assert.Equal(t, "", updated.Get("user_id"))
+	assert.Empty(t, updated.Get("metadata"))
+	assert.Empty(t, updated.Get("aliases"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a key deleted outside of Terraform
+	resourceData.SetId(testHashedToken)
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
+
+func TestResourceKeyImport(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/key/list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("return_full_object"))
+		switch r.URL.Query().Get("key_alias") {
+		case "ci-key":
+			w.Write([]byte(`{"keys": [{"token": "` + testHashedToken + `", "key_alias": "ci-key"}]}`))
+		case "shared-key":
+			w.Write([]byte(`{"keys": [{"token": "a", "key_alias": "shared-key"}, {"token": "b", "key_alias": "shared-key"}]}`))
+		default:
+			w.Write([]byte(`{"keys": []}`))
+		}
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_key"]
+
+	// Test import by hashed token
+	resourceData := resource.TestResourceData()
+	resourceData.SetId(testHashedToken)
+	imported, err := resource.Importer.StateContext(context.Background(), resourceData, meta)
+	assert.NoError(t, err)
+	assert.Equal(t, testHashedToken, imported[0].Id())
+
+	// Test import by key alias
+	resourceData = resource.TestResourceData()
+	resourceData.SetId("ci-key")
+	imported, err = resource.Importer.StateContext(context.Background(), resourceData, meta)
+	assert.NoError(t, err)
+	assert.Equal(t, testHashedToken, imported[0].Id())
+
+	// Test import by an alias shared by several keys
+	resourceData = resource.TestResourceData()
+	resourceData.SetId("shared-key")
+	_, err = resource.Importer.StateContext(context.Background(), resourceData, meta)
+	assert.ErrorContains(t, err, "matches 2 keys")
+
+	// Test import of an unknown alias
+	resourceData = resource.TestResourceData()
+	resourceData.SetId("unknown-key")
+	_, err = resource.Importer.StateContext(context.Background(), resourceData, meta)
+	assert.True(t, strings.Contains(err.Error(), "not found"))
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+var (
+	mcpTransports = []string{"sse", "http", "stdio"}
+	mcpAuthTypes  = []string{"none", "api_key", "bearer_token", "basic", "authorization"}
+)
+
+func resourceMCPServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMCPServerCreate,
+		ReadContext:   resourceMCPServerRead,
+		UpdateContext: resourceMCPServerUpdate,
+		DeleteContext: resourceMCPServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The server_id of the MCP server.",
+			},
+			"server_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the MCP server. Generated by the proxy when omitted.",
+			},
+			"server_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the MCP server, used as prefix of its tools.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the MCP server.",
+			},
+			"transport": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "sse",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(mcpTransports, false)),
+				Description:      "Transport used to reach the MCP server, one of `sse`, `http` or `stdio`.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the MCP server, required by the `sse` and `http` transports.",
+			},
+			"command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Command starting the MCP server, required by the `stdio` transport.",
+			},
+			"args": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Arguments of `command`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"env": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateSecretMap,
+				Description:      "Environment variables of `command`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"auth_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(mcpAuthTypes, false)),
+				Description:      "Authentication used by the proxy towards the MCP server, one of `none`, `api_key`, `bearer_token`, `basic` or `authorization`.",
+			},
+			"auth_value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateSecret,
+				Description:      "Secret matching `auth_type`, e.g. the API key or the bearer token. The proxy never returns it, so changes made outside of Terraform are not detected.",
+			},
+			"auth_value_wo": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				WriteOnly:        true,
+				ValidateDiagFunc: validateSecret,
+				ConflictsWith:    []string{"auth_value"},
+				Description:      "Write-only secret matching `auth_type`, sent when the MCP server is created or updated but never stored in the state. Requires Terraform 1.11 or later, bump `auth_value_wo_version` to send a new value.",
+			},
+			"auth_value_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of `auth_value_wo`. Terraform cannot detect changes of write-only attributes, change it to update the MCP server with its current value.",
+			},
+			"allowed_tools": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Tools of the MCP server exposed through the proxy. Every tool is exposed when empty.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mcp_access_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Access groups the MCP server belongs to, granting keys and teams of these groups access to it.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceMCPServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	server := expandMCPServer(d)
+	server.ServerID = d.Get("server_id").(string)
+
+	created, err := client.CreateMCPServer(ctx, server)
+	if err != nil {
+		return diagFromErr(err, resourceMCPServer().Schema)
+	}
+
+	d.SetId(created.ServerID)
+
+	return resourceMCPServerRead(ctx, d, m)
+}
+
+func resourceMCPServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	server, err := client.GetMCPServer(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The server was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceMCPServer().Schema)
+	}
+
+	// auth_value is left untouched, the proxy never returns it
+	attributes := map[string]interface{}{
+		"server_id":         d.Id(),
+		"server_name":       server.ServerName,
+		"description":       server.Description,
+		"transport":         server.Transport,
+		"url":               server.URL,
+		"command":           server.Command,
+		"args":              server.Args,
+		"env":               flattenStringMap(server.Env, d.Get("env").(map[string]interface{})),
+		"auth_type":         server.AuthType,
+		"allowed_tools":     server.AllowedTools,
+		"mcp_access_groups": server.MCPAccessGroups,
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceMCPServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	server := expandMCPServer(d)
+	server.ServerID = d.Id()
+
+	if err := client.UpdateMCPServer(ctx, server); err != nil {
+		return diagFromErr(err, resourceMCPServer().Schema)
+	}
+
+	return resourceMCPServerRead(ctx, d, m)
+}
+
+func resourceMCPServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteMCPServer(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceMCPServer().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandMCPServer builds the API representation of the MCP server from the resource configuration
+func expandMCPServer(d *schema.ResourceData) *litellmapi.MCPServer {
+	server := &litellmapi.MCPServer{
+		ServerName:      d.Get("server_name").(string),
+		Description:     d.Get("description").(string),
+		Transport:       d.Get("transport").(string),
+		URL:             d.Get("url").(string),
+		Command:         d.Get("command").(string),
+		Args:            expandStringList(d.Get("args").([]interface{})),
+		Env:             d.Get("env").(map[string]interface{}),
+		AuthType:        d.Get("auth_type").(string),
+		AllowedTools:    expandStringList(d.Get("allowed_tools").([]interface{})),
+		MCPAccessGroups: expandStringList(d.Get("mcp_access_groups").([]interface{})),
+	}
+	authValue := d.Get("auth_value").(string)
+	if authValueWO := writeOnlyString(d, cty.GetAttrPath("auth_value_wo")); authValueWO != "" {
+		authValue = authValueWO
+	}
+	if authValue != "" {
+		server.Credentials = &litellmapi.MCPCredentials{AuthValue: authValue}
+	}
+	return server
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceMCPServerCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/mcp/server", func(w http.ResponseWriter, r *http.Request) {
+		request := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		switch r.Method {
+		case http.MethodPost:
+			request["server_id"] = "mcp-1"
+		case http.MethodPut:
+			assert.Equal(t, "mcp-1", request["server_id"])
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		stored = request
+		json.NewEncoder(w).Encode(stored)
+	})
+	mux.HandleFunc("/v1/mcp/server/mcp-1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if stored == nil {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"detail": {"error": "MCP Server with id mcp-1 not found"}}`))
+				return
+			}
+			// The proxy never returns the credentials
+			response := map[string]interface{}{}
+			for k, v := range stored {
+				if k != "credentials" {
+					response[k] = v
+				}
+			}
+			json.NewEncoder(w).Encode(response)
+		case http.MethodDelete:
+			stored = nil
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_mcp_server"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("server_name", "github")
+	resourceData.Set("url", "https://api.githubcopilot.com/mcp")
+	resourceData.Set("transport", "http")
+	resourceData.Set("auth_type", "bearer_token")
+	resourceData.Set("auth_value", "ghp_secret")
+	resourceData.Set("allowed_tools", []interface{}{"list_issues", "get_issue"})
+	resourceData.Set("mcp_access_groups", []interface{}{"dev"})
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "mcp-1", resourceData.Id())
+	assert.Equal(t, "mcp-1", resourceData.Get("server_id"))
+	assert.Equal(t, map[string]interface{}{"auth_value": "ghp_secret"}, stored["credentials"])
+	assert.Equal(t, "ghp_secret", resourceData.Get("auth_value"))
+	assert.Equal(t, []interface{}{"list_issues", "get_issue"}, resourceData.Get("allowed_tools"))
+
+	// Test Read detects drift
+	stored["mcp_access_groups"] = []interface{}{"dev", "ops"}
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{"dev", "ops"}, resourceData.Get("mcp_access_groups"))
+
+	// Test Update
+	resourceData.Set("description", "GitHub tools")
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "GitHub tools", stored["description"])
+	assert.Equal(t, map[string]interface{}{"auth_value": "ghp_secret"}, stored["credentials"])
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a server deleted outside of Terraform
+	resourceData.SetId("mcp-1")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
@@ -1,14 +1,18 @@
 package provider
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
 )
 
 func resourceModel() *schema.Resource {
@@ -17,6 +21,23 @@ func resourceModel() *schema.Resource {
 		ReadContext:   resourceModelRead,
 		UpdateContext: resourceModelUpdate,
 		DeleteContext: resourceModelDelete,
+		CustomizeDiff: resourceModelCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceModelImport,
+		},
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceModelV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceModelStateUpgradeV0,
+			},
+			{
+				Version: 1,
+				Type:    resourceModelV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceModelStateUpgradeV1,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -29,158 +50,756 @@ func resourceModel() *schema.Resource {
 				Description: "Name of the model to be managed.",
 			},
 			"litellm_params": {
-				Type:        schema.TypeMap,
+				Type:        schema.TypeList,
 				Required:    true,
+				MaxItems:    1,
 				Description: "Parameters for the model as per LiteLLM API.",
+				Elem:        resourceModelLitellmParams(),
+			},
+			"model_info": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Information about the deployment, such as its ID, pricing and capabilities.",
+				Elem:        resourceModelInfo(),
+			},
+			"litellm_credential_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a `litellm_credential` providing the credentials of the deployment instead of setting them in `litellm_params`.",
+			},
+		},
+	}
+}
+
+// modelSecretParams are the sensitive attributes of the litellm_params block. The proxy strips or masks
+// secrets in /model/info, so Read never refreshes them and changes made outside of Terraform are not detected.
+var modelSecretParams = []string{"api_key", "aws_access_key_id", "aws_secret_access_key", "aws_session_token", "vertex_credentials", "client_secret"}
+
+// modelLocalParams are the attributes of the litellm_params block that are not sent as is to the proxy
+var modelLocalParams = map[string]bool{
+	"extra_params":       true,
+	"secret_params":      true,
+	"api_key_wo":         true,
+	"secret_params_wo":   true,
+	"secrets_wo_version": true,
+}
+
+// resourceModelLitellmParams is the litellm_params block. Well-known params are typed so that they are
+// sent with their JSON type, anything else goes through the extra_params JSON object, or secret_params for secrets.
+func resourceModelLitellmParams() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"model": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Underlying model called by the deployment, e.g. `gpt-4o` or `azure/gpt-4o`.",
+			},
+			"custom_llm_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Provider of the model, e.g. `openai`, `azure` or `bedrock`. Inferred from `model` when omitted.",
+			},
+			"api_base": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base URL of the provider API.",
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Version of the provider API, e.g. for Azure OpenAI.",
+			},
+			"api_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateSecret,
+				Description:      "API key of the provider.",
+			},
+			"aws_access_key_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateSecret,
+				Description:      "AWS access key ID of Bedrock and SageMaker models.",
+			},
+			"aws_secret_access_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateSecret,
+				Description:      "AWS secret access key of Bedrock and SageMaker models.",
+			},
+			"aws_session_token": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateSecret,
+				Description:      "AWS session token of Bedrock and SageMaker models.",
+			},
+			"vertex_credentials": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateSecret,
+				Description:      "Service account key of Vertex AI models, as JSON.",
+			},
+			"client_secret": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateSecret,
+				Description:      "Client secret of Azure models authenticating with Entra ID.",
+			},
+			"secret_params": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "Any other secret params, merged into litellm_params when the model is created or updated.",
+				ValidateDiagFunc: validateSecretMap,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
-			"model_info": {
-				Type:        schema.TypeMap,
+			"api_key_wo": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				WriteOnly:        true,
+				ValidateDiagFunc: validateSecret,
+				ConflictsWith:    []string{"litellm_params.0.api_key"},
+				Description:      "Write-only API key of the provider, sent when the model is created or updated but never stored in the state. Requires Terraform 1.11 or later, bump `secrets_wo_version` to send a new value.",
+			},
+			"secret_params_wo": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				WriteOnly:        true,
+				ValidateDiagFunc: validateSecretJSON,
+				Description:      "Write-only JSON object of secret params, merged into litellm_params like `secret_params` but never stored in the state. Requires Terraform 1.11 or later, bump `secrets_wo_version` to send new values.",
+			},
+			"secrets_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of the write-only secrets. Terraform cannot detect changes of write-only attributes, change it to update the model with their current values.",
+			},
+			"aws_region_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS region of Bedrock and SageMaker models.",
+			},
+			"vertex_project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Google Cloud project of Vertex AI models.",
+			},
+			"vertex_location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Google Cloud location of Vertex AI models.",
+			},
+			"rpm": {
+				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Additional model information.",
+				Description: "Requests per minute limit of the deployment, used for load balancing.",
+			},
+			"tpm": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Tokens per minute limit of the deployment, used for load balancing.",
+			},
+			"timeout": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Timeout in seconds of the requests to the provider.",
+			},
+			"stream_timeout": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Timeout in seconds of the streaming requests to the provider.",
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Number of retries of the requests to the provider.",
+			},
+			"use_in_pass_through": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the credentials of the deployment are used by the pass-through endpoints of the provider.",
+			},
+			"input_cost_per_token": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Cost in USD of an input token, overriding the price known by LiteLLM.",
+			},
+			"output_cost_per_token": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Cost in USD of an output token, overriding the price known by LiteLLM.",
+			},
+			"extra_params": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc:    normalizeJSON,
+				Description:  "JSON object of any other params, sent as is, e.g. `jsonencode({ region_name = [\"us-east-1\"] })`.",
+			},
+		},
+	}
+}
+
+// modelModes are the kinds of calls a deployment serves, used by the proxy for health checks and routing
+var modelModes = []string{"chat", "embedding", "image_generation", "audio_transcription", "rerank"}
+
+// resourceModelInfo is the model_info block. Like litellm_params, anything without a typed attribute goes through extra_info.
+func resourceModelInfo() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the deployment, a UUID is generated when omitted. Changing it replaces the deployment.",
+			},
+			"base_model": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Model the deployment is priced as, e.g. `gpt-4o` for an Azure deployment with a custom name.",
+			},
+			"tier": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"free", "paid"}, false)),
+				Description:      "Tier of the deployment, `free` or `paid`.",
+			},
+			"mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(modelModes, false)),
+				Description:      "Kind of calls served by the deployment, one of `chat`, `embedding`, `image_generation`, `audio_transcription` or `rerank`.",
+			},
+			"input_cost_per_token": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Cost in USD of an input token, used for spend tracking.",
+			},
+			"output_cost_per_token": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Cost in USD of an output token, used for spend tracking.",
+			},
+			"max_tokens": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of tokens of the model.",
+			},
+			"supports_vision": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the model accepts images.",
+			},
+			"supports_function_calling": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the model supports function calling.",
+			},
+			"access_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Access groups of the deployment. Leave it empty when the groups of the deployment are managed by `litellm_access_group`.",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"extra_info": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc:    normalizeJSON,
+				Description:  "JSON object of any other model information, sent as is.",
+			},
 		},
 	}
 }
 
 func resourceModelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*LitellmClient)
+	client := m.(*litellmapi.LitellmClient)
 
 	var diags diag.Diagnostics
 
-	modelName := d.Get("model_name").(string)
-	litellmParams := d.Get("litellm_params").(map[string]interface{})
-	modelInfo, _ := d.Get("model_info").(map[string]interface{})
-
-	if modelInfo == nil || modelInfo["id"] == nil {
-		return diag.Errorf("model_info.id is required")
-	}
-
-	requestBody := map[string]interface{}{
-		"model_name":     modelName,
-		"litellm_params": litellmParams,
-		"model_info":     modelInfo,
-	}
-
-	jsonData, err := json.Marshal(requestBody)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	url := fmt.Sprintf("%s/model/new", client.ApiBaseURL)
-	req, err := client.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	model, err := expandModel(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return diag.FromErr(err)
+	if model.ID() == "" {
+		id, err := uuid.GenerateUUID()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		model.ModelInfo["id"] = id
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return diag.Errorf("API request failed with status code %d", resp.StatusCode)
+	if err := client.CreateModel(ctx, model); err != nil {
+		return diagFromErr(err, resourceModel().Schema)
 	}
 
 	// Set the ID of the resource
-	d.SetId(modelInfo["id"].(string))
+	d.SetId(model.ID())
+
+	// model_info.id is computed when omitted, record the one the model was created with
+	modelInfo := map[string]interface{}{}
+	for k, v := range modelInfoBlock(d) {
+		modelInfo[k] = v
+	}
+	modelInfo["id"] = model.ID()
+	if err := d.Set("model_info", []interface{}{modelInfo}); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
 
 func resourceModelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
 	var diags diag.Diagnostics
-	// Implement the Read function if the API supports it
 
-	// For now, we'll assume the resource always exists
+	model, err := client.GetModel(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The model was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceModel().Schema)
+	}
+
+	if err := setModelState(d, model); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
-func resourceModelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*LitellmClient)
+// resourceModelCustomizeDiff replaces the deployment when model_info.id changes: /model/update identifies the
+// deployment by its id, so updating it in place would leave the old deployment behind. Removing the id from the
+// configuration keeps the one in the state, generated or not, since the attribute is computed.
+func resourceModelCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && d.HasChange("model_info.0.id") {
+		return d.ForceNew("model_info.0.id")
+	}
+	return nil
+}
 
-	var diags diag.Diagnostics
+// resourceModelImport accepts either a model ID or a model_name matching a single deployment
+func resourceModelImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*litellmapi.LitellmClient)
 
-	modelName := d.Get("model_name").(string)
-	litellmParams := d.Get("litellm_params").(map[string]interface{})
-	modelInfo, _ := d.Get("model_info").(map[string]interface{})
+	_, err := client.GetModel(ctx, d.Id())
+	if err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+	if !litellmapi.IsNotFound(err) {
+		return nil, err
+	}
+
+	models, err := client.ListModels(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if modelInfo == nil || modelInfo["id"] == nil {
-		return diag.Errorf("model_info.id is required")
+	var ids []string
+	for _, model := range models {
+		if model.ModelName == d.Id() && model.ID() != "" {
+			ids = append(ids, model.ID())
+		}
 	}
 
-	requestBody := map[string]interface{}{
-		"model_name":     modelName,
-		"litellm_params": litellmParams,
-		"model_info":     modelInfo,
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("no model found with id or model_name %q", d.Id())
+	case 1:
+		d.SetId(ids[0])
+		return []*schema.ResourceData{d}, nil
+	default:
+		return nil, fmt.Errorf("model_name %q matches %d deployments (%s), import one of them by id instead", d.Id(), len(ids), strings.Join(ids, ", "))
 	}
+}
 
-	jsonData, err := json.Marshal(requestBody)
+func resourceModelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	model, err := expandModel(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	model.ModelInfo["id"] = d.Id()
 
-	url := fmt.Sprintf("%s/model/update", client.ApiBaseURL)
-	req, err := client.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	// The proxy keeps the params and info missing from an update, the ones removed from the configuration are sent as null
+	previousInfo, _ := d.GetChange("model_info")
+	previousModelInfo, err := expandModelInfo(previousInfo.([]interface{}), cty.NullVal(cty.DynamicPseudoType))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	for name := range previousModelInfo {
+		if _, found := model.ModelInfo[name]; !found {
+			model.ModelInfo[name] = nil
+		}
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	previous, _ := d.GetChange("litellm_params")
+	previousParams, err := expandModelParams(previous.([]interface{}), cty.NullVal(cty.DynamicPseudoType))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	defer resp.Body.Close()
+	if oldCredential, _ := d.GetChange("litellm_credential_name"); oldCredential.(string) != "" {
+		previousParams["litellm_credential_name"] = oldCredential
+	}
+	for name := range previousParams {
+		if _, found := model.LitellmParams[name]; !found {
+			model.LitellmParams[name] = nil
+		}
+	}
 
-	if resp.StatusCode != http.StatusOK {
-		return diag.Errorf("API request failed with status code %d", resp.StatusCode)
+	if err := client.UpdateModel(ctx, model); err != nil {
+		return diagFromErr(err, resourceModel().Schema)
 	}
 
 	return diags
 }
 
 func resourceModelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*LitellmClient)
+	client := m.(*litellmapi.LitellmClient)
 
 	var diags diag.Diagnostics
 
-	modelInfo := d.Get("model_info").(map[string]interface{})
-	if modelInfo == nil || modelInfo["id"] == nil {
-		return diag.Errorf("model_info.id is required")
+	if err := client.DeleteModel(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceModel().Schema)
 	}
 
-	requestBody := map[string]interface{}{
-		"id": modelInfo["id"].(string),
+	d.SetId("")
+
+	return diags
+}
+
+// expandModel builds the API representation of the model from the resource configuration
+func expandModel(d *schema.ResourceData) (*litellmapi.Model, error) {
+	// The raw configuration tells attributes set to their zero value, such as rpm = 0, apart from unset ones
+	modelInfo, err := expandModelInfo(d.Get("model_info").([]interface{}), rawBlock(d, "model_info"))
+	if err != nil {
+		return nil, err
 	}
 
-	jsonData, err := json.Marshal(requestBody)
+	litellmParams, err := expandModelParams(d.Get("litellm_params").([]interface{}), rawBlock(d, "litellm_params"))
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
+	}
+	if credentialName := d.Get("litellm_credential_name").(string); credentialName != "" {
+		litellmParams["litellm_credential_name"] = credentialName
 	}
 
-	url := fmt.Sprintf("%s/model/delete", client.ApiBaseURL)
-	req, err := client.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	// Write-only secrets are only in the configuration, they are empty when the model is deleted
+	writeOnlyPath := cty.GetAttrPath("litellm_params").IndexInt(0)
+	secrets, err := writeOnlySecrets(d, writeOnlyPath.GetAttr("secret_params_wo"))
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
+	}
+	for k, v := range secrets {
+		litellmParams[k] = v
+	}
+	if apiKey := writeOnlyString(d, writeOnlyPath.GetAttr("api_key_wo")); apiKey != "" {
+		litellmParams["api_key"] = apiKey
+	}
+
+	return &litellmapi.Model{
+		ModelName:     d.Get("model_name").(string),
+		LitellmParams: litellmParams,
+		ModelInfo:     modelInfo,
+	}, nil
+}
+
+// expandModelParams builds litellm_params from the extra_params object and the typed params of the block,
+// unset typed params are left out so that the proxy applies its defaults. config is the block in the raw
+// configuration, without it, as for the state or in unit tests, params holding their zero value are unset.
+func expandModelParams(blocks []interface{}, config cty.Value) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
+	if len(blocks) == 0 || blocks[0] == nil {
+		return params, nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	if extraParams := block["extra_params"].(string); extraParams != "" {
+		if err := json.Unmarshal([]byte(extraParams), &params); err != nil {
+			return nil, fmt.Errorf("litellm_params.extra_params: %w", err)
+		}
+	}
+
+	for name, attribute := range resourceModelLitellmParams().Schema {
+		if modelLocalParams[name] {
+			continue
+		}
+		value := block[name]
+		switch value.(type) {
+		case string, int, float64, bool:
+		default:
+			return nil, fmt.Errorf("litellm_params.%s: unexpected %s value %v", name, attribute.Type, value)
+		}
+		if config.IsNull() || !config.IsKnown() {
+			if !isZeroValue(value) {
+				params[name] = value
+			}
+		} else if !config.GetAttr(name).IsNull() {
+			params[name] = value
+		}
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	for k, v := range block["secret_params"].(map[string]interface{}) {
+		params[k] = v
+	}
+	return params, nil
+}
+
+// flattenModelParams converts the litellm_params returned by the API into the litellm_params block.
+// current is the block in the state, nil on import. Secrets keep the value from the state, and extra_params
+// only refreshes the keys it already holds, unless there is no state yet.
+func flattenModelParams(remote map[string]interface{}, current map[string]interface{}) ([]interface{}, error) {
+	typed := resourceModelLitellmParams().Schema
+	block := map[string]interface{}{}
+
+	for name, attribute := range typed {
+		if modelLocalParams[name] {
+			continue
+		}
+		block[name] = convertValue(remote[name], attribute.Type)
+	}
+
+	// Write-only attributes are never stored, only their version is kept from the state
+	block["secrets_wo_version"] = 0
+	if current != nil {
+		block["secrets_wo_version"] = current["secrets_wo_version"]
+	}
+
+	// Secrets always keep the value from the state, the proxy only returns them masked if at all
+	secretParams := map[string]interface{}{}
+	for _, name := range modelSecretParams {
+		block[name] = ""
+		if current != nil {
+			block[name] = current[name]
+		}
+	}
+	if current != nil {
+		secretParams, _ = current["secret_params"].(map[string]interface{})
+	}
+	block["secret_params"] = secretParams
+
+	extra := map[string]interface{}{}
+	if current == nil {
+		for k, v := range remote {
+			if _, isTyped := typed[k]; !isTyped && v != nil {
+				extra[k] = v
+			}
+		}
+	} else if extraParams, _ := current["extra_params"].(string); extraParams != "" {
+		if err := json.Unmarshal([]byte(extraParams), &extra); err != nil {
+			return nil, fmt.Errorf("litellm_params.extra_params: %w", err)
+		}
+		for k := range extra {
+			if v, found := remote[k]; found {
+				extra[k] = v
+			}
+		}
+	}
+	delete(extra, "litellm_credential_name")
+	for k := range secretParams {
+		delete(extra, k)
+	}
+
+	block["extra_params"] = ""
+	if len(extra) > 0 {
+		encoded, err := json.Marshal(extra)
+		if err != nil {
+			return nil, err
+		}
+		block["extra_params"] = string(encoded)
+	}
+
+	return []interface{}{block}, nil
+}
+
+// rawBlock returns the single nested block name in the raw configuration, null when there is none
+func rawBlock(d *schema.ResourceData, name string) cty.Value {
+	config, err := cty.GetAttrPath(name).IndexInt(0).Apply(d.GetRawConfig())
 	if err != nil {
-		return diag.FromErr(err)
+		return cty.NullVal(cty.DynamicPseudoType)
 	}
-	defer resp.Body.Close()
+	return config
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return diag.Errorf("API request failed with status code %d", resp.StatusCode)
+// expandModelInfo builds model_info from the extra_info object and the typed attributes of the block,
+// unset attributes are left out so that the proxy keeps the information it knows about the model. config
+// is the block in the raw configuration, without it attributes holding their zero value are unset.
+func expandModelInfo(blocks []interface{}, config cty.Value) (map[string]interface{}, error) {
+	info := map[string]interface{}{}
+	if len(blocks) == 0 || blocks[0] == nil {
+		return info, nil
 	}
+	block := blocks[0].(map[string]interface{})
 
-	d.SetId("")
+	if extraInfo := block["extra_info"].(string); extraInfo != "" {
+		if err := json.Unmarshal([]byte(extraInfo), &info); err != nil {
+			return nil, fmt.Errorf("model_info.extra_info: %w", err)
+		}
+	}
 
-	return diags
+	for name, attribute := range resourceModelInfo().Schema {
+		if name == "extra_info" {
+			continue
+		}
+		value := block[name]
+		switch list := value.(type) {
+		case string, int, float64, bool:
+		case []interface{}:
+			value = expandStringList(list)
+		default:
+			return nil, fmt.Errorf("model_info.%s: unexpected %s value %v", name, attribute.Type, value)
+		}
+		if config.IsNull() || !config.IsKnown() {
+			if !isZeroValue(block[name]) {
+				info[name] = value
+			}
+		} else if !config.GetAttr(name).IsNull() {
+			info[name] = value
+		}
+	}
+	return info, nil
+}
+
+// modelInfoDefaults are the model_info attributes the proxy fills with what it knows about the underlying model
+var modelInfoDefaults = map[string]bool{
+	"mode":                      true,
+	"input_cost_per_token":      true,
+	"output_cost_per_token":     true,
+	"max_tokens":                true,
+	"supports_vision":           true,
+	"supports_function_calling": true,
+}
+
+// flattenModelInfo converts the model_info returned by the API into the model_info block. current is the
+// block in the state, nil on import. modelInfoDefaults and access_groups, which litellm_access_group manages
+// too, are only refreshed when set in the state so that they do not show up as drift. On import, every
+// attribute but modelInfoDefaults is imported.
+func flattenModelInfo(remote map[string]interface{}, current map[string]interface{}) ([]interface{}, error) {
+	block := map[string]interface{}{}
+
+	for name, attribute := range resourceModelInfo().Schema {
+		if name == "extra_info" {
+			continue
+		}
+		value := current[name]
+		if value == nil {
+			value = attribute.ZeroValue()
+		}
+		remoteValue, found := remote[name]
+		switch {
+		case !found:
+		case isZeroValue(value) && (modelInfoDefaults[name] || name == "access_groups" && current != nil):
+		case attribute.Type == schema.TypeList:
+			groups, _ := remoteValue.([]interface{})
+			value = groups
+		default:
+			value = convertValue(remoteValue, attribute.Type)
+		}
+		block[name] = value
+	}
+
+	block["extra_info"] = ""
+	if extraInfo, _ := current["extra_info"].(string); extraInfo != "" {
+		extra := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(extraInfo), &extra); err != nil {
+			return nil, fmt.Errorf("model_info.extra_info: %w", err)
+		}
+		for k := range extra {
+			if v, found := remote[k]; found {
+				extra[k] = v
+			}
+		}
+		encoded, err := json.Marshal(extra)
+		if err != nil {
+			return nil, err
+		}
+		block["extra_info"] = string(encoded)
+	}
+
+	return []interface{}{block}, nil
+}
+
+// isZeroValue reports whether v is the zero value of its attribute type, i.e. whether the attribute is unset
+func isZeroValue(v interface{}) bool {
+	switch value := v.(type) {
+	case string:
+		return value == ""
+	case int:
+		return value == 0
+	case float64:
+		return value == 0
+	case bool:
+		return !value
+	case []interface{}:
+		return len(value) == 0
+	default:
+		return v == nil
+	}
+}
+
+// modelInfoBlock returns the model_info block of the state, nil when there is none
+func modelInfoBlock(d *schema.ResourceData) map[string]interface{} {
+	if blocks := d.Get("model_info").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		return blocks[0].(map[string]interface{})
+	}
+	return nil
+}
+
+// setModelState refreshes model_name, litellm_params, model_info and litellm_credential_name from a deployment returned by the API
+func setModelState(d *schema.ResourceData, model *litellmapi.Model) error {
+	if err := d.Set("model_name", model.ModelName); err != nil {
+		return err
+	}
+
+	// litellm_credential_name has its own attribute, keep it out of litellm_params
+	remoteParams := map[string]interface{}{}
+	for k, v := range model.LitellmParams {
+		remoteParams[k] = v
+	}
+	credentialName, _ := remoteParams["litellm_credential_name"].(string)
+	delete(remoteParams, "litellm_credential_name")
+	if err := d.Set("litellm_credential_name", credentialName); err != nil {
+		return err
+	}
+
+	var currentParams map[string]interface{}
+	if blocks := d.Get("litellm_params").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		currentParams = blocks[0].(map[string]interface{})
+	}
+	litellmParams, err := flattenModelParams(remoteParams, currentParams)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("litellm_params", litellmParams); err != nil {
+		return err
+	}
+
+	modelInfo, err := flattenModelInfo(model.ModelInfo, modelInfoBlock(d))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("model_info", modelInfo); err != nil {
+		return err
+	}
+
+	return nil
 }
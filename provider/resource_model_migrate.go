@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// secretParamPattern matches the names of untyped params holding secrets, e.g. azure_ad_token or vertex_credentials
+var secretParamPattern = regexp.MustCompile(`(?i)(key|secret|token|password|credentials)$`)
+
+// resourceModelV0 is the schema of litellm_model before litellm_params became a typed block
+func resourceModelV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"litellm_params": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"model_info": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"litellm_credential_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceModelStateUpgradeV0 moves the litellm_params string map into the typed block, params without
+// a typed attribute are kept in extra_params, or in the sensitive secret_params when they look like secrets
+func resourceModelStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	params, _ := rawState["litellm_params"].(map[string]interface{})
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	typed := resourceModelLitellmParams().Schema
+	untyped := map[string]interface{}{}
+	secretParams := map[string]interface{}{}
+	for name, value := range params {
+		if _, isTyped := typed[name]; !isTyped && secretParamPattern.MatchString(name) {
+			secretParams[name] = value
+			continue
+		}
+		untyped[name] = value
+	}
+
+	litellmParams, err := flattenModelParams(untyped, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// flattenModelParams never reads secrets from the API, here they come from the state itself
+	block := litellmParams[0].(map[string]interface{})
+	for _, name := range modelSecretParams {
+		if value, ok := params[name].(string); ok {
+			block[name] = value
+		}
+	}
+	block["secret_params"] = secretParams
+	rawState["litellm_params"] = litellmParams
+
+	return rawState, nil
+}
+
+// resourceModelV1 is the schema of litellm_model before model_info became a typed block
+func resourceModelV1() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"litellm_params": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     resourceModelLitellmParams(),
+			},
+			"model_info": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"litellm_credential_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceModelStateUpgradeV1 moves the model_info string map into the typed block,
+// keys without a typed attribute are kept in extra_info
+func resourceModelStateUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	info, _ := rawState["model_info"].(map[string]interface{})
+
+	block := map[string]interface{}{}
+	extra := map[string]interface{}{}
+	typed := resourceModelInfo().Schema
+	for k, v := range info {
+		attribute, isTyped := typed[k]
+		switch {
+		case !isTyped || k == "extra_info":
+			extra[k] = v
+		case attribute.Type == schema.TypeList:
+			// access_groups could not be set in a string map, only a comma separated string may be found
+			if groups, ok := v.(string); ok && groups != "" {
+				block[k] = strings.Split(groups, ",")
+			}
+		default:
+			block[k] = convertValue(v, attribute.Type)
+		}
+	}
+
+	if len(extra) > 0 {
+		encoded, err := json.Marshal(extra)
+		if err != nil {
+			return nil, err
+		}
+		block["extra_info"] = string(encoded)
+	}
+	rawState["model_info"] = []interface{}{block}
+
+	return rawState, nil
+}
@@ -2,12 +2,14 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,6 +28,27 @@ func TestResourceModelCreateUpdateDelete(t *testing.T) {
 
 		assert.Equal(t, expectedToken, token)
 
+		var body struct {
+			LitellmParams map[string]interface{} `json:"litellm_params"`
+			ModelInfo     map[string]interface{} `json:"model_info"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "gpt-3.5-turbo", body.LitellmParams["model"])
+		assert.Equal(t, float64(10), body.LitellmParams["rpm"])
+		assert.Equal(t, 30.5, body.LitellmParams["timeout"])
+		assert.Equal(t, []interface{}{"us-east-1"}, body.LitellmParams["region_name"])
+		assert.NotContains(t, body.LitellmParams, "tpm")
+		assert.Equal(t, "underlying-api-key", body.LitellmParams["api_key"])
+		assert.Equal(t, "underlying-secret", body.LitellmParams["aws_secret_access_key"])
+		assert.Equal(t, "underlying-token", body.LitellmParams["azure_ad_token"])
+		assert.Equal(t, map[string]interface{}{
+			"id":            "unique-model-id",
+			"base_model":    "gpt-3.5-turbo",
+			"tier":          "paid",
+			"mode":          "chat",
+			"access_groups": []interface{}{"beta-models"},
+		}, body.ModelInfo)
+
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`{}`))
 	})
@@ -67,29 +90,501 @@ func TestResourceModelCreateUpdateDelete(t *testing.T) {
 
 	resourceData := schema.TestResourceDataRaw(t, p.ResourcesMap["litellm_model"].Schema, map[string]interface{}{
 		"model_name": "test-model",
-		"litellm_params": map[string]interface{}{
+		"litellm_params": []interface{}{map[string]interface{}{
+			"custom_llm_provider":   "openai",
+			"model":                 "gpt-3.5-turbo",
+			"api_key":               "underlying-api-key",
+			"rpm":                   10,
+			"timeout":               30.5,
+			"extra_params":          `{"region_name": ["us-east-1"]}`,
+			"aws_secret_access_key": "underlying-secret",
+			"secret_params":         map[string]interface{}{"azure_ad_token": "underlying-token"},
+		}},
+		"model_info": []interface{}{map[string]interface{}{
+			"id":            "unique-model-id",
+			"base_model":    "gpt-3.5-turbo",
+			"tier":          "paid",
+			"mode":          "chat",
+			"access_groups": []interface{}{"beta-models"},
+		}},
+	})
+
+	// Test Create
+	diags = p.ResourcesMap["litellm_model"].CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "unique-model-id", resourceData.Id())
+
+	// Test Update
+	diags = p.ResourcesMap["litellm_model"].UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+
+	// Test Delete
+	diags = p.ResourcesMap["litellm_model"].DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
+
+func TestResourceModelRead(t *testing.T) {
+	apiToken := "test-token"
+	deleted := false
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/model/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("Bearer %s", apiToken), r.Header.Get("Authorization"))
+		assert.Equal(t, "unique-model-id", r.URL.Query().Get("litellm_model_id"))
+
+		if deleted {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "Model id = unique-model-id not found on litellm proxy"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{
+			"model_name": "renamed-model",
+			"litellm_params": {"custom_llm_provider": "openai", "model": "gpt-4", "rpm": 10, "api_key": "sk-****", "litellm_credential_name": "openai-prod"},
+			"model_info": {"id": "unique-model-id", "base_model": "gpt-4", "tier": "paid", "db_model": true, "mode": "chat", "max_tokens": 8192, "supports_vision": true, "access_groups": ["beta-models"]}
+		}]}`))
+	})
+	mux.HandleFunc("/model/delete", func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, deleted)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"detail": {"error": "Model with id=unique-model-id not found in db"}}`))
+	})
+
+	p := NewProvider()
+	providerConfig := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"api_token":    apiToken,
+		"api_base_url": server.URL,
+	})
+	meta, diags := p.ConfigureContextFunc(context.Background(), providerConfig)
+	if diags.HasError() {
+		t.Fatalf("Failed to configure provider: %s", diags[0].Summary)
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, p.ResourcesMap["litellm_model"].Schema, map[string]interface{}{
+		"model_name": "test-model",
+		"litellm_params": []interface{}{map[string]interface{}{
 			"custom_llm_provider": "openai",
 			"model":               "gpt-3.5-turbo",
 			"api_key":             "underlying-api-key",
-		},
-		"model_info": map[string]interface{}{
+		}},
+		"model_info": []interface{}{map[string]interface{}{
 			"id":         "unique-model-id",
 			"base_model": "gpt-3.5-turbo",
 			"tier":       "paid",
-		},
+		}},
 	})
+	resourceData.SetId("unique-model-id")
 
-	// Test Create
-	diags = p.ResourcesMap["litellm_model"].CreateContext(context.Background(), resourceData, meta)
+	// Test Read refreshes the state from the API
+	diags = p.ResourcesMap["litellm_model"].ReadContext(context.Background(), resourceData, meta)
 	assert.False(t, diags.HasError())
 	assert.Equal(t, "unique-model-id", resourceData.Id())
+	assert.Equal(t, "renamed-model", resourceData.Get("model_name"))
+	assert.Equal(t, "openai", resourceData.Get("litellm_params.0.custom_llm_provider"))
+	assert.Equal(t, "gpt-4", resourceData.Get("litellm_params.0.model"))
+	assert.Equal(t, 10, resourceData.Get("litellm_params.0.rpm"))
+	assert.Equal(t, "underlying-api-key", resourceData.Get("litellm_params.0.api_key"))
+	assert.Equal(t, "", resourceData.Get("litellm_params.0.extra_params"))
+	assert.Equal(t, "openai-prod", resourceData.Get("litellm_credential_name"))
+	assert.Equal(t, "unique-model-id", resourceData.Get("model_info.0.id"))
+	assert.Equal(t, "gpt-4", resourceData.Get("model_info.0.base_model"))
+	assert.Equal(t, "paid", resourceData.Get("model_info.0.tier"))
 
-	// Test Update
-	diags = p.ResourcesMap["litellm_model"].UpdateContext(context.Background(), resourceData, meta)
+	// Test Read ignores the defaults filled by the proxy and the access groups managed elsewhere
+	assert.Equal(t, "", resourceData.Get("model_info.0.mode"))
+	assert.Equal(t, 0, resourceData.Get("model_info.0.max_tokens"))
+	assert.Equal(t, false, resourceData.Get("model_info.0.supports_vision"))
+	assert.Equal(t, []interface{}{}, resourceData.Get("model_info.0.access_groups"))
+	assert.Equal(t, "", resourceData.Get("model_info.0.extra_info"))
+
+	// Test Read removes a model deleted outside of Terraform
+	deleted = true
+	diags = p.ResourcesMap["litellm_model"].ReadContext(context.Background(), resourceData, meta)
 	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
 
-	// Test Delete
+	// Test Delete succeeds for a model deleted outside of Terraform
+	resourceData.SetId("unique-model-id")
 	diags = p.ResourcesMap["litellm_model"].DeleteContext(context.Background(), resourceData, meta)
 	assert.False(t, diags.HasError())
 	assert.Equal(t, "", resourceData.Id())
 }
+
+func TestResourceModelWriteOnlySecrets(t *testing.T) {
+	var sent []map[string]interface{}
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			LitellmParams map[string]interface{} `json:"litellm_params"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		sent = append(sent, body.LitellmParams)
+		w.Write([]byte(`{}`))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/model/new", record)
+	mux.HandleFunc("/model/update", record)
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_model"]
+
+	config := map[string]interface{}{
+		"model_name": "test-model",
+		"litellm_params": []interface{}{map[string]interface{}{
+			"model":              "gpt-4o",
+			"api_key_wo":         "sk-write-only",
+			"secret_params_wo":   `{"azure_ad_token": "os.environ/AZURE_AD_TOKEN"}`,
+			"secrets_wo_version": 1,
+		}},
+		"model_info": []interface{}{map[string]interface{}{"id": "unique-model-id"}},
+	}
+
+	// Test Create sends the write-only secrets
+	resourceData := planUpdate(t, resource, resource.TestResourceData(), config)
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "sk-write-only", sent[0]["api_key"])
+	assert.Equal(t, "os.environ/AZURE_AD_TOKEN", sent[0]["azure_ad_token"])
+	assert.NotContains(t, sent[0], "api_key_wo")
+	assert.NotContains(t, sent[0], "secrets_wo_version")
+
+	// Test Read keeps the version but never stores the write-only secrets
+	block, err := flattenModelParams(map[string]interface{}{"model": "gpt-4o", "api_key": "sk-****"}, resourceData.Get("litellm_params.0").(map[string]interface{}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, block[0].(map[string]interface{})["secrets_wo_version"])
+	assert.NotContains(t, block[0], "api_key_wo")
+	assert.Equal(t, "", block[0].(map[string]interface{})["api_key"])
+
+	// Test bumping the version sends the rotated secret again
+	config["litellm_params"] = []interface{}{map[string]interface{}{
+		"model":              "gpt-4o",
+		"api_key_wo":         "sk-rotated",
+		"secrets_wo_version": 2,
+	}}
+	updated := planUpdate(t, resource, resourceData, config)
+	assert.True(t, updated.HasChange("litellm_params.0.secrets_wo_version"))
+	diags = resource.UpdateContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "sk-rotated", sent[1]["api_key"])
+	assert.NotContains(t, sent[1], "azure_ad_token")
+}
+
+func TestResourceModelUpdateZeroAndRemovedParams(t *testing.T) {
+	var sent []map[string]interface{}
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			LitellmParams map[string]interface{} `json:"litellm_params"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		sent = append(sent, body.LitellmParams)
+		w.Write([]byte(`{}`))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/model/new", record)
+	mux.HandleFunc("/model/update", record)
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_model"]
+
+	config := map[string]interface{}{
+		"model_name": "test-model",
+		"litellm_params": []interface{}{map[string]interface{}{
+			"model":               "gpt-4o",
+			"rpm":                 10,
+			"max_retries":         0,
+			"use_in_pass_through": true,
+			"extra_params":        `{"region_name": "us-east-1"}`,
+		}},
+		"model_info": []interface{}{map[string]interface{}{"id": "unique-model-id"}},
+	}
+
+	// Test Create sends the params explicitly set to their zero value
+	resourceData := planUpdate(t, resource, resource.TestResourceData(), config)
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, float64(0), sent[0]["max_retries"])
+	assert.NotContains(t, sent[0], "tpm")
+
+	// Test Update sends false and null for the params turned off or removed from the configuration
+	config["litellm_params"] = []interface{}{map[string]interface{}{
+		"model":               "gpt-4o",
+		"use_in_pass_through": false,
+	}}
+	updated := planUpdate(t, resource, resourceData, config)
+	diags = resource.UpdateContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, false, sent[1]["use_in_pass_through"])
+	assert.Contains(t, sent[1], "rpm")
+	assert.Nil(t, sent[1]["rpm"])
+	assert.Contains(t, sent[1], "region_name")
+	assert.Nil(t, sent[1]["region_name"])
+	assert.NotContains(t, sent[1], "tpm")
+}
+
+func TestResourceModelInfoZeroAndRemovedAttributes(t *testing.T) {
+	var sent []map[string]interface{}
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ModelInfo map[string]interface{} `json:"model_info"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		sent = append(sent, body.ModelInfo)
+		w.Write([]byte(`{}`))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/model/new", record)
+	mux.HandleFunc("/model/update", record)
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_model"]
+
+	config := map[string]interface{}{
+		"model_name":     "test-model",
+		"litellm_params": []interface{}{map[string]interface{}{"model": "gpt-4o"}},
+		"model_info": []interface{}{map[string]interface{}{
+			"id":              "unique-model-id",
+			"base_model":      "gpt-4o",
+			"tier":            "paid",
+			"supports_vision": false,
+			"extra_info":      `{"description": "Main chat model"}`,
+		}},
+	}
+
+	// Test Create sends the attributes explicitly set to their zero value
+	resourceData := planUpdate(t, resource, resource.TestResourceData(), config)
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, false, sent[0]["supports_vision"])
+	assert.NotContains(t, sent[0], "max_tokens")
+
+	// Test Update sends null for the attributes removed from the configuration
+	config["model_info"] = []interface{}{map[string]interface{}{
+		"id":   "unique-model-id",
+		"tier": "paid",
+	}}
+	updated := planUpdate(t, resource, resourceData, config)
+	diags = resource.UpdateContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "unique-model-id", sent[1]["id"])
+	assert.Equal(t, "paid", sent[1]["tier"])
+	for _, name := range []string{"base_model", "description"} {
+		assert.Contains(t, sent[1], name)
+		assert.Nil(t, sent[1][name], name)
+	}
+
+	// Test Read shows a value left on the proxy as drift
+	block, err := flattenModelInfo(map[string]interface{}{"id": "unique-model-id", "base_model": "gpt-4o", "tier": "paid", "max_tokens": 8192}, updated.Get("model_info.0").(map[string]interface{}))
+	assert.NoError(t, err)
+	assert.Equal(t, "gpt-4o", block[0].(map[string]interface{})["base_model"])
+	assert.Equal(t, 0, block[0].(map[string]interface{})["max_tokens"])
+}
+
+func TestResourceModelImport(t *testing.T) {
+	apiToken := "test-token"
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/model/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("Bearer %s", apiToken), r.Header.Get("Authorization"))
+
+		deployments := `[
+			{"model_name": "single-model", "litellm_params": {"model": "gpt-4"}, "model_info": {"id": "model-id-1", "tier": "paid"}},
+			{"model_name": "shared-model", "litellm_params": {"model": "gpt-4"}, "model_info": {"id": "model-id-2"}},
+			{"model_name": "shared-model", "litellm_params": {"model": "gpt-4"}, "model_info": {"id": "model-id-3"}}
+		]`
+		switch r.URL.Query().Get("litellm_model_id") {
+		case "":
+			w.Write([]byte(`{"data": ` + deployments + `}`))
+		case "model-id-1":
+			w.Write([]byte(`{"data": [{"model_name": "single-model", "litellm_params": {"model": "gpt-4"}, "model_info": {"id": "model-id-1", "tier": "paid"}}]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "Model id = ` + r.URL.Query().Get("litellm_model_id") + ` not found on litellm proxy"}`))
+		}
+	})
+
+	p := NewProvider()
+	providerConfig := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"api_token":    apiToken,
+		"api_base_url": server.URL,
+	})
+	meta, diags := p.ConfigureContextFunc(context.Background(), providerConfig)
+	if diags.HasError() {
+		t.Fatalf("Failed to configure provider: %s", diags[0].Summary)
+	}
+
+	resource := p.ResourcesMap["litellm_model"]
+
+	// Test import by ID
+	resourceData := resource.TestResourceData()
+	resourceData.SetId("model-id-1")
+	imported, err := resource.Importer.StateContext(context.Background(), resourceData, meta)
+	assert.NoError(t, err)
+	assert.Len(t, imported, 1)
+	assert.Equal(t, "model-id-1", imported[0].Id())
+
+	diags = resource.ReadContext(context.Background(), imported[0], meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "single-model", imported[0].Get("model_name"))
+	assert.Equal(t, "gpt-4", imported[0].Get("litellm_params.0.model"))
+	assert.Equal(t, "", imported[0].Get("litellm_params.0.extra_params"))
+	assert.Equal(t, "model-id-1", imported[0].Get("model_info.0.id"))
+	assert.Equal(t, "paid", imported[0].Get("model_info.0.tier"))
+
+	// Test import by model_name
+	resourceData = resource.TestResourceData()
+	resourceData.SetId("single-model")
+	imported, err = resource.Importer.StateContext(context.Background(), resourceData, meta)
+	assert.NoError(t, err)
+	assert.Equal(t, "model-id-1", imported[0].Id())
+
+	// Test import by a model_name shared by several deployments
+	resourceData = resource.TestResourceData()
+	resourceData.SetId("shared-model")
+	_, err = resource.Importer.StateContext(context.Background(), resourceData, meta)
+	assert.ErrorContains(t, err, "matches 2 deployments")
+
+	// Test import of an unknown model
+	resourceData = resource.TestResourceData()
+	resourceData.SetId("unknown-model")
+	_, err = resource.Importer.StateContext(context.Background(), resourceData, meta)
+	assert.ErrorContains(t, err, "no model found")
+}
+
+func TestResourceModelStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":         "unique-model-id",
+		"model_name": "test-model",
+		"litellm_params": map[string]interface{}{
+			"model":                 "gpt-4",
+			"api_key":               "underlying-api-key",
+			"aws_secret_access_key": "underlying-secret",
+			"rpm":                   "10",
+			"timeout":               "30.5",
+			"region_name":           "us-east-1",
+			"azure_ad_token":        "underlying-token",
+			"max_tokens":            "1024",
+		},
+		"model_info": map[string]interface{}{"id": "unique-model-id"},
+	}
+
+	upgraded, err := resourceModelStateUpgradeV0(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+
+	params := upgraded["litellm_params"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "gpt-4", params["model"])
+	assert.Equal(t, "underlying-api-key", params["api_key"])
+	assert.Equal(t, "underlying-secret", params["aws_secret_access_key"])
+	assert.Equal(t, 10, params["rpm"])
+	assert.Equal(t, 30.5, params["timeout"])
+	assert.Equal(t, `{"max_tokens":"1024","region_name":"us-east-1"}`, params["extra_params"])
+	assert.Equal(t, map[string]interface{}{"azure_ad_token": "underlying-token"}, params["secret_params"])
+	assert.Equal(t, map[string]interface{}{"id": "unique-model-id"}, upgraded["model_info"])
+}
+
+func TestResourceModelStateUpgradeV1(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":         "unique-model-id",
+		"model_name": "test-model",
+		"model_info": map[string]interface{}{
+			"id":              "unique-model-id",
+			"tier":            "paid",
+			"max_tokens":      "8192",
+			"supports_vision": "true",
+			"access_groups":   "beta-models,internal",
+			"description":     "Main chat model",
+		},
+	}
+
+	upgraded, err := resourceModelStateUpgradeV1(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+
+	info := upgraded["model_info"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "unique-model-id", info["id"])
+	assert.Equal(t, "paid", info["tier"])
+	assert.Equal(t, 8192, info["max_tokens"])
+	assert.Equal(t, true, info["supports_vision"])
+	assert.Equal(t, []string{"beta-models", "internal"}, info["access_groups"])
+	assert.Equal(t, `{"description":"Main chat model"}`, info["extra_info"])
+}
+
+func TestResourceModelGeneratedID(t *testing.T) {
+	var created map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/model/new", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ModelInfo map[string]interface{} `json:"model_info"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		created = body.ModelInfo
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_model"]
+
+	resourceData := planUpdate(t, resource, resource.TestResourceData(), map[string]interface{}{
+		"model_name":     "test-model",
+		"litellm_params": []interface{}{map[string]interface{}{"model": "gpt-4o"}},
+		"model_info": []interface{}{map[string]interface{}{
+			"mode":       "chat",
+			"extra_info": `{"description": "Main chat model"}`,
+		}},
+	})
+
+	// Test Create generates the ID when model_info.id is omitted
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, resourceData.Id())
+	assert.Equal(t, resourceData.Id(), created["id"])
+	assert.Equal(t, resourceData.Id(), resourceData.Get("model_info.0.id"))
+	assert.Equal(t, "chat", created["mode"])
+	assert.Equal(t, "Main chat model", created["description"])
+	assert.Equal(t, "chat", resourceData.Get("model_info.0.mode"))
+}
+
+func TestResourceModelCustomizeDiff(t *testing.T) {
+	resource := resourceModel()
+
+	state := resource.TestResourceData()
+	state.SetId("generated-id")
+	state.Set("model_name", "test-model")
+	state.Set("litellm_params", []interface{}{map[string]interface{}{"model": "gpt-4o"}})
+	state.Set("model_info", []interface{}{map[string]interface{}{"id": "generated-id", "tier": "paid"}})
+
+	config := map[string]interface{}{
+		"model_name":     "test-model",
+		"litellm_params": []interface{}{map[string]interface{}{"model": "gpt-4o"}},
+		"model_info":     []interface{}{map[string]interface{}{"tier": "free"}},
+	}
+
+	// Test an omitted id keeps the one in the state
+	diff, err := resource.Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+	assert.NotContains(t, diff.Attributes, "model_info.0.id")
+	assert.Equal(t, "free", diff.Attributes["model_info.0.tier"].New)
+
+	// Test changing the id replaces the deployment
+	config["model_info"] = []interface{}{map[string]interface{}{"id": "custom-id", "tier": "paid"}}
+	diff, err = resource.Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.RequiresNew())
+	assert.Equal(t, "custom-id", diff.Attributes["model_info.0.id"].New)
+
+	// Test setting the id already in the state is a no-op
+	config["model_info"] = []interface{}{map[string]interface{}{"id": "generated-id", "tier": "paid"}}
+	diff, err = resource.Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, diff)
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func resourceOrganization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationCreate,
+		ReadContext:   resourceOrganizationRead,
+		UpdateContext: resourceOrganizationUpdate,
+		DeleteContext: resourceOrganizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The organization_id of the organization.",
+			},
+			"organization_alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "User-friendly name of the organization.",
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Model names the organization can call. An empty list gives access to every model.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum spend in USD for the organization.",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Period after which the spend of the organization is reset, e.g. `30s`, `30m`, `30h`, `30d`.",
+			},
+			"tpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Tokens per minute limit of the organization.",
+			},
+			"rpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Requests per minute limit of the organization.",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Metadata attached to the organization.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	organization, err := client.CreateOrganization(ctx, expandOrganization(d))
+	if err != nil {
+		return diagFromErr(err, resourceOrganization().Schema)
+	}
+
+	d.SetId(organization.OrganizationID)
+
+	return resourceOrganizationRead(ctx, d, m)
+}
+
+func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	organization, err := client.GetOrganization(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The organization was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceOrganization().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"organization_alias": organization.OrganizationAlias,
+		"models":             organization.Models,
+		"max_budget":         derefFloat(organization.MaxBudget),
+		"budget_duration":    organization.BudgetDuration,
+		"tpm_limit":          derefInt(organization.TpmLimit),
+		"rpm_limit":          derefInt(organization.RpmLimit),
+		"metadata":           flattenStringMap(organization.Metadata, d.Get("metadata").(map[string]interface{})),
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	organization := expandOrganization(d)
+	organization.OrganizationID = d.Id()
+
+	cleared := clearedAttributes(d, "max_budget", "budget_duration", "tpm_limit", "rpm_limit", "metadata")
+	if err := client.UpdateOrganization(ctx, organization, cleared...); err != nil {
+		return diagFromErr(err, resourceOrganization().Schema)
+	}
+
+	return resourceOrganizationRead(ctx, d, m)
+}
+
+func resourceOrganizationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteOrganization(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceOrganization().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandOrganization builds the API representation of the organization from the resource configuration
+func expandOrganization(d *schema.ResourceData) *litellmapi.Organization {
+	return &litellmapi.Organization{
+		OrganizationAlias: d.Get("organization_alias").(string),
+		Models:            expandStringList(d.Get("models").([]interface{})),
+		MaxBudget:         optionalFloat(d, "max_budget"),
+		BudgetDuration:    d.Get("budget_duration").(string),
+		TpmLimit:          optionalInt(d, "tpm_limit"),
+		RpmLimit:          optionalInt(d, "rpm_limit"),
+		Metadata:          d.Get("metadata").(map[string]interface{}),
+	}
+}
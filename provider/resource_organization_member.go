@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+var organizationRoles = []string{"org_admin", "internal_user", "internal_user_viewer"}
+
+func resourceOrganizationMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationMemberCreate,
+		ReadContext:   resourceOrganizationMemberRead,
+		UpdateContext: resourceOrganizationMemberUpdate,
+		DeleteContext: resourceOrganizationMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrganizationMemberImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the membership, in the form `organization_id/user_id:<user_id>` or `organization_id/user_email:<user_email>`.",
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Organization the user is a member of.",
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"user_id", "user_email"},
+				Description:  "ID of the user. Exactly one of `user_id` and `user_email` must be set.",
+			},
+			"user_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"user_id", "user_email"},
+				Description:  "Email of the user. Exactly one of `user_id` and `user_email` must be set.",
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "internal_user",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(organizationRoles, false)),
+				Description:      "Role of the user in the organization, one of `org_admin`, `internal_user` or `internal_user_viewer`.",
+			},
+			"max_budget_in_organization": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum spend in USD of the user within the organization.",
+			},
+		},
+	}
+}
+
+func resourceOrganizationMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	organizationID := d.Get("organization_id").(string)
+	member := expandOrganizationMember(d)
+
+	if err := client.AddOrganizationMember(ctx, organizationID, member); err != nil {
+		return diagFromErr(err, resourceOrganizationMember().Schema)
+	}
+
+	d.SetId(memberID(organizationID, member.UserID, member.UserEmail))
+
+	return resourceOrganizationMemberRead(ctx, d, m)
+}
+
+func resourceOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	organizationID, userID, userEmail, err := parseMemberID(d.Id(), "organization_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := client.GetOrganizationMember(ctx, organizationID, userID, userEmail)
+	if litellmapi.IsNotFound(err) {
+		// The member was removed outside of Terraform, drop it from the state so it gets added back
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceOrganizationMember().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"organization_id":            organizationID,
+		"user_id":                    member.UserID,
+		"user_email":                 member.UserEmail,
+		"role":                       member.Role,
+		"max_budget_in_organization": derefFloat(member.MaxBudgetInOrganization),
+	}
+	if member.UserEmail == "" {
+		// The proxy does not always return the email of members, keep the configured one
+		attributes["user_email"] = d.Get("user_email").(string)
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceOrganizationMemberUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	err := client.UpdateOrganizationMember(ctx, d.Get("organization_id").(string), expandOrganizationMember(d))
+	if err != nil {
+		return diagFromErr(err, resourceOrganizationMember().Schema)
+	}
+
+	return resourceOrganizationMemberRead(ctx, d, m)
+}
+
+func resourceOrganizationMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	err := client.RemoveOrganizationMember(ctx, d.Get("organization_id").(string), expandOrganizationMember(d))
+	if err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceOrganizationMember().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceOrganizationMemberImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := parseMemberID(d.Id(), "organization_id"); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandOrganizationMember builds the API representation of the member, preferring user_id when both identifiers are known
+func expandOrganizationMember(d *schema.ResourceData) *litellmapi.OrganizationMember {
+	member := &litellmapi.OrganizationMember{
+		UserID:                  d.Get("user_id").(string),
+		Role:                    d.Get("role").(string),
+		MaxBudgetInOrganization: optionalFloat(d, "max_budget_in_organization"),
+	}
+	if member.UserID == "" {
+		member.UserEmail = d.Get("user_email").(string)
+	}
+	return member
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceOrganizationMemberCRUD(t *testing.T) {
+	var members []map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/organization/member_add", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body := struct {
+			OrganizationID          string                   `json:"organization_id"`
+			Member                  []map[string]interface{} `json:"member"`
+			MaxBudgetInOrganization float64                  `json:"max_budget_in_organization"`
+		}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "org-id-1", body.OrganizationID)
+		assert.Equal(t, "jane-id", body.Member[0]["user_id"])
+		members = append(members, map[string]interface{}{
+			"user_id":              "jane-id",
+			"user_role":            body.Member[0]["role"],
+			"user":                 map[string]interface{}{"user_email": "jane@example.com"},
+			"litellm_budget_table": map[string]interface{}{"max_budget": body.MaxBudgetInOrganization},
+		})
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/organization/info", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"organization_id": "org-id-1", "members": members})
+	})
+	mux.HandleFunc("/organization/member_update", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		body := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		members[0]["user_role"] = body["role"]
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/organization/member_delete", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		body := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "jane-id", body["user_id"])
+		members = nil
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_organization_member"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("organization_id", "org-id-1")
+	resourceData.Set("user_id", "jane-id")
+	resourceData.Set("role", "internal_user")
+	resourceData.Set("max_budget_in_organization", 30.0)
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "org-id-1/user_id:jane-id", resourceData.Id())
+	assert.Equal(t, "jane@example.com", resourceData.Get("user_email"))
+	assert.Equal(t, 30.0, resourceData.Get("max_budget_in_organization"))
+
+	// Test Update
+	resourceData.Set("role", "org_admin")
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "org_admin", resourceData.Get("role"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read detects a member removed outside of Terraform
+	resourceData.SetId("org-id-1/user_id:jane-id")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceOrganizationCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/organization/new", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		stored["organization_id"] = "org-id-1"
+		json.NewEncoder(w).Encode(stored)
+	})
+	mux.HandleFunc("/organization/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "org-id-1", r.URL.Query().Get("organization_id"))
+		if stored == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"organization_id":    "org-id-1",
+			"organization_alias": stored["organization_alias"],
+			"models":             stored["models"],
+			"metadata":           stored["metadata"],
+			"litellm_budget_table": map[string]interface{}{
+				"max_budget":      stored["max_budget"],
+				"budget_duration": stored["budget_duration"],
+				"tpm_limit":       stored["tpm_limit"],
+				"rpm_limit":       stored["rpm_limit"],
+			},
+		})
+	})
+	mux.HandleFunc("/organization/update", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		update := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+		assert.Equal(t, "org-id-1", update["organization_id"])
+		for k, v := range update {
+			stored[k] = v
+		}
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/organization/delete", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		body := map[string][]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"org-id-1"}, body["organization_ids"])
+		stored = nil
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_organization"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("organization_alias", "acme")
+	resourceData.Set("models", []interface{}{"gpt-4o"})
+	resourceData.Set("max_budget", 1000.0)
+	resourceData.Set("rpm_limit", 100)
+	resourceData.Set("metadata", map[string]interface{}{"owner": "platform"})
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "org-id-1", resourceData.Id())
+	assert.Equal(t, 1000.0, resourceData.Get("max_budget"))
+	assert.Equal(t, 100, resourceData.Get("rpm_limit"))
+
+	// Test Update
+	resourceData.Set("organization_alias", "acme-corp")
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "acme-corp", resourceData.Get("organization_alias"))
+
+	// Test Update clears the limits and metadata removed from the configuration
+	resourceData = planUpdate(t, resource, resourceData, map[string]interface{}{
+		"organization_alias": "acme-corp",
+		"models":             []interface{}{"gpt-4o"},
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, stored, "max_budget")
+	assert.Nil(t, stored["max_budget"])
+	assert.Nil(t, stored["rpm_limit"])
+	assert.Contains(t, stored, "metadata")
+	assert.Nil(t, stored["metadata"])
+	assert.Empty(t, resourceData.Get("metadata"))
+	assert.Equal(t, 0.0, resourceData.Get("max_budget"))
+	assert.Equal(t, 0, resourceData.Get("rpm_limit"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes an organization deleted outside of Terraform
+	resourceData.SetId("org-id-1")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+// resourcePassThroughEndpoint has no update, the proxy has no update operation for pass-through endpoints
+// so every change replaces the endpoint.
+func resourcePassThroughEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePassThroughEndpointCreate,
+		ReadContext:   resourcePassThroughEndpointRead,
+		DeleteContext: resourcePassThroughEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path of the endpoint.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path on which the proxy serves the endpoint, e.g. `/v1/rerank`.",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "URL the requests are forwarded to, e.g. `https://api.cohere.com/v1/rerank`.",
+			},
+			"headers": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateSecretMap,
+				Description:      "Headers added to the forwarded requests, typically the credentials of the target API. Use `os.environ/VARIABLE_NAME` values to keep the credentials out of the state.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"forward_headers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the headers of the incoming request are forwarded to the target.",
+			},
+			"include_subpath": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the requests on sub paths of `path` are forwarded too, with the sub path appended to `target`.",
+			},
+			"auth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether callers must authenticate with a LiteLLM key. Requires an enterprise license of LiteLLM.",
+			},
+		},
+	}
+}
+
+func resourcePassThroughEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	endpoint := expandPassThroughEndpoint(d)
+	if err := client.CreatePassThroughEndpoint(ctx, endpoint); err != nil {
+		return diagFromErr(err, resourcePassThroughEndpoint().Schema)
+	}
+
+	d.SetId(endpoint.Path)
+
+	return resourcePassThroughEndpointRead(ctx, d, m)
+}
+
+func resourcePassThroughEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	endpoint, err := client.GetPassThroughEndpoint(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The endpoint was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourcePassThroughEndpoint().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"path":            endpoint.Path,
+		"target":          endpoint.Target,
+		"headers":         flattenStringMap(endpoint.Headers, d.Get("headers").(map[string]interface{})),
+		"forward_headers": endpoint.ForwardHeaders,
+		"include_subpath": endpoint.IncludeSubpath,
+		"auth":            endpoint.Auth,
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourcePassThroughEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeletePassThroughEndpoint(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourcePassThroughEndpoint().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandPassThroughEndpoint builds the API representation of the endpoint from the resource configuration
+func expandPassThroughEndpoint(d *schema.ResourceData) *litellmapi.PassThroughEndpoint {
+	return &litellmapi.PassThroughEndpoint{
+		Path:           d.Get("path").(string),
+		Target:         d.Get("target").(string),
+		Headers:        d.Get("headers").(map[string]interface{}),
+		ForwardHeaders: d.Get("forward_headers").(bool),
+		IncludeSubpath: d.Get("include_subpath").(bool),
+		Auth:           d.Get("auth").(bool),
+	}
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+const (
+	// promptContentParam is the litellm_params key holding the template of dotprompt prompts
+	promptContentParam = "dotprompt_content"
+
+	defaultPromptIntegration = "dotprompt"
+)
+
+func resourcePrompt() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePromptCreate,
+		ReadContext:   resourcePromptRead,
+		UpdateContext: resourcePromptUpdate,
+		DeleteContext: resourcePromptDelete,
+		CustomizeDiff: resourcePromptCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The prompt_id of the prompt.",
+			},
+			"prompt_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the prompt, used by apps to reference it.",
+			},
+			"prompt_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "db",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"db", "config"}, false)),
+				Description:      "Where the proxy stores the prompt, `db` or `config`.",
+			},
+			"template": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_file"},
+				Description:   "Content of the prompt template, in the format of the prompt integration.",
+			},
+			"template_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template"},
+				Description:   "Path of a file holding the prompt template. Changes to the content of the file are detected through `template_hash`.",
+			},
+			"template_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 hash of the prompt template stored in the proxy.",
+			},
+			"litellm_params": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Parameters of the prompt as per LiteLLM API, e.g. `prompt_integration`, which defaults to `dotprompt`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Version of the prompt, incremented by the proxy on each update.",
+			},
+		},
+	}
+}
+
+func resourcePromptCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	prompt, err := expandPrompt(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.CreatePrompt(ctx, prompt); err != nil {
+		return diagFromErr(err, resourcePrompt().Schema)
+	}
+
+	d.SetId(prompt.PromptID)
+
+	return resourcePromptRead(ctx, d, m)
+}
+
+func resourcePromptRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	prompt, err := client.GetPrompt(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The prompt was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourcePrompt().Schema)
+	}
+
+	remoteTemplate, _ := prompt.LitellmParams[promptContentParam].(string)
+	remoteHash := contentHash(remoteTemplate)
+	if currentHash := d.Get("template_hash").(string); currentHash != "" && currentHash != remoteHash {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The template of prompt %s differs from the one applied by Terraform", d.Id()),
+			Detail:   "The template stored in the proxy was changed outside of Terraform, the next apply restores the configured template.",
+		})
+	}
+
+	promptType := "db"
+	if prompt.PromptInfo != nil && prompt.PromptInfo.PromptType != "" {
+		promptType = prompt.PromptInfo.PromptType
+	}
+
+	attributes := map[string]interface{}{
+		"prompt_id":      d.Id(),
+		"prompt_type":    promptType,
+		"template_hash":  remoteHash,
+		"litellm_params": flattenPromptParams(prompt.LitellmParams, d.Get("litellm_params").(map[string]interface{})),
+		"version":        prompt.Version,
+	}
+	// A template read from a file stays in the file, drift is reported through template_hash
+	if d.Get("template_file").(string) == "" {
+		attributes["template"] = remoteTemplate
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourcePromptUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	prompt, err := expandPrompt(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.UpdatePrompt(ctx, prompt); err != nil {
+		return diagFromErr(err, resourcePrompt().Schema)
+	}
+
+	return resourcePromptRead(ctx, d, m)
+}
+
+func resourcePromptDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeletePrompt(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourcePrompt().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourcePromptCustomizeDiff plans template_hash from the configured template, so that changes to
+// the content of template_file, or to the template stored in the proxy, show up as a diff
+func resourcePromptCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("template") || !d.NewValueKnown("template_file") {
+		return d.SetNewComputed("template_hash")
+	}
+
+	template, err := promptTemplate(d.Get("template").(string), d.Get("template_file").(string))
+	if err != nil {
+		return err
+	}
+
+	if hash := contentHash(template); hash != d.Get("template_hash").(string) {
+		if err := d.SetNew("template_hash", hash); err != nil {
+			return err
+		}
+	}
+
+	if d.Id() != "" && (d.HasChange("template_hash") || d.HasChange("litellm_params") || d.HasChange("prompt_type")) {
+		return d.SetNewComputed("version")
+	}
+	return nil
+}
+
+// expandPrompt builds the API representation of the prompt from the resource configuration
+func expandPrompt(d *schema.ResourceData) (*litellmapi.Prompt, error) {
+	template, err := promptTemplate(d.Get("template").(string), d.Get("template_file").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	promptID := d.Get("prompt_id").(string)
+
+	litellmParams := map[string]interface{}{
+		"prompt_id":          promptID,
+		"prompt_integration": defaultPromptIntegration,
+	}
+	for k, v := range d.Get("litellm_params").(map[string]interface{}) {
+		litellmParams[k] = v
+	}
+	if template != "" {
+		litellmParams[promptContentParam] = template
+	}
+
+	return &litellmapi.Prompt{
+		PromptID:      promptID,
+		LitellmParams: litellmParams,
+		PromptInfo: &litellmapi.PromptInfo{
+			PromptType: d.Get("prompt_type").(string),
+		},
+	}, nil
+}
+
+// promptTemplate returns the inline template, or the content of the template file
+func promptTemplate(template, templateFile string) (string, error) {
+	if templateFile == "" {
+		return template, nil
+	}
+	content, err := os.ReadFile(templateFile)
+	if err != nil {
+		return "", fmt.Errorf("reading template_file: %w", err)
+	}
+	return string(content), nil
+}
+
+// contentHash returns the hex encoded SHA256 hash of content, or an empty string when there is no content
+func contentHash(content string) string {
+	if content == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// flattenPromptParams refreshes litellm_params, leaving out the keys managed by other attributes
+// and prompt_integration when it has its default value and is not configured
+func flattenPromptParams(remote map[string]interface{}, current map[string]interface{}) map[string]string {
+	params := map[string]interface{}{}
+	for k, v := range remote {
+		params[k] = v
+	}
+	delete(params, "prompt_id")
+	delete(params, promptContentParam)
+	if _, configured := current["prompt_integration"]; !configured && params["prompt_integration"] == defaultPromptIntegration {
+		delete(params, "prompt_integration")
+	}
+	return flattenStringMap(params, current)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourcePromptCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/prompts", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		stored["version"] = 1
+		json.NewEncoder(w).Encode(stored)
+	})
+	mux.HandleFunc("/prompts/support-agent/info", func(w http.ResponseWriter, r *http.Request) {
+		if stored == nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Prompt support-agent not found"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"prompt_spec": stored})
+	})
+	mux.HandleFunc("/prompts/support-agent", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			version := stored["version"].(int)
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+			stored["version"] = version + 1
+		case http.MethodDelete:
+			stored = nil
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	templateFile := filepath.Join(t.TempDir(), "support-agent.prompt")
+	assert.NoError(t, os.WriteFile(templateFile, []byte("You are a support agent for {{product}}."), 0o600))
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_prompt"]
+	config := map[string]interface{}{
+		"prompt_id":      "support-agent",
+		"template_file":  templateFile,
+		"litellm_params": map[string]interface{}{"model": "gpt-4o"},
+	}
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("prompt_id", "support-agent")
+	resourceData.Set("prompt_type", "db")
+	resourceData.Set("template_file", templateFile)
+	resourceData.Set("litellm_params", map[string]interface{}{"model": "gpt-4o"})
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "support-agent", resourceData.Id())
+	params := stored["litellm_params"].(map[string]interface{})
+	assert.Equal(t, "You are a support agent for {{product}}.", params["dotprompt_content"])
+	assert.Equal(t, "dotprompt", params["prompt_integration"])
+	assert.Equal(t, "support-agent", params["prompt_id"])
+	assert.Equal(t, contentHash("You are a support agent for {{product}}."), resourceData.Get("template_hash"))
+	assert.Equal(t, map[string]interface{}{"model": "gpt-4o"}, resourceData.Get("litellm_params"))
+	assert.Equal(t, "", resourceData.Get("template"))
+	assert.Equal(t, 1, resourceData.Get("version"))
+
+	// Test Read reports a template changed outside of Terraform
+	params["dotprompt_content"] = "You are a sales agent."
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, contentHash("You are a sales agent."), resourceData.Get("template_hash"))
+
+	// Test the plan restores the template of the file
+	updated := planUpdate(t, resource, resourceData, config)
+	assert.Equal(t, contentHash("You are a support agent for {{product}}."), updated.Get("template_hash"))
+	diags = resource.UpdateContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "You are a support agent for {{product}}.", stored["litellm_params"].(map[string]interface{})["dotprompt_content"])
+	assert.Equal(t, 2, updated.Get("version"))
+
+	// Test the plan detects changes to the content of the file
+	assert.NoError(t, os.WriteFile(templateFile, []byte("You are a support agent."), 0o600))
+	updated = planUpdate(t, resource, updated, config)
+	assert.Equal(t, contentHash("You are a support agent."), updated.Get("template_hash"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", updated.Id())
+
+	// Test Read removes a prompt deleted outside of Terraform
+	updated.SetId("support-agent")
+	diags = resource.ReadContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", updated.Id())
+}
+
+func TestResourcePromptImport(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/prompts/support-agent/info", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"prompt_spec": {
+			"prompt_id": "support-agent",
+			"litellm_params": {"prompt_id": "support-agent", "prompt_integration": "dotprompt", "dotprompt_content": "Hello"},
+			"prompt_info": {"prompt_type": "db"},
+			"version": 3
+		}}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_prompt"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.SetId("support-agent")
+	diags := resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Empty(t, diags)
+	assert.Equal(t, "Hello", resourceData.Get("template"))
+	assert.Equal(t, map[string]interface{}{}, resourceData.Get("litellm_params"))
+	assert.Equal(t, 3, resourceData.Get("version"))
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func resourceTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTagCreate,
+		ReadContext:   resourceTagRead,
+		UpdateContext: resourceTagUpdate,
+		DeleteContext: resourceTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the tag.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the tag, as sent by clients in the `tags` of the request metadata.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the tag.",
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "IDs of the `litellm_model` deployments requests carrying the tag are routed to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum spend in USD of the requests carrying the tag.",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Period after which the spend of the tag is reset, e.g. `30s`, `30m`, `30h`, `30d`.",
+			},
+			"tpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Tokens per minute limit of the tag.",
+			},
+			"rpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Requests per minute limit of the tag.",
+			},
+		},
+	}
+}
+
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	tag := expandTag(d)
+	if err := client.CreateTag(ctx, tag); err != nil {
+		return diagFromErr(err, resourceTag().Schema)
+	}
+
+	d.SetId(tag.Name)
+
+	return resourceTagRead(ctx, d, m)
+}
+
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	tag, err := client.GetTag(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The tag was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceTag().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"name":            tag.Name,
+		"description":     tag.Description,
+		"models":          tag.Models,
+		"max_budget":      derefFloat(tag.MaxBudget),
+		"budget_duration": tag.BudgetDuration,
+		"tpm_limit":       derefInt(tag.TpmLimit),
+		"rpm_limit":       derefInt(tag.RpmLimit),
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	cleared := clearedAttributes(d, "description", "max_budget", "budget_duration", "tpm_limit", "rpm_limit")
+	if err := client.UpdateTag(ctx, expandTag(d), cleared...); err != nil {
+		return diagFromErr(err, resourceTag().Schema)
+	}
+
+	return resourceTagRead(ctx, d, m)
+}
+
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteTag(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceTag().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandTag builds the API representation of the tag from the resource configuration
+func expandTag(d *schema.ResourceData) *litellmapi.Tag {
+	return &litellmapi.Tag{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Models:         expandStringList(d.Get("models").([]interface{})),
+		MaxBudget:      optionalFloat(d, "max_budget"),
+		BudgetDuration: d.Get("budget_duration").(string),
+		TpmLimit:       optionalInt(d, "tpm_limit"),
+		RpmLimit:       optionalInt(d, "rpm_limit"),
+	}
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceTagCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/tag/new", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		w.Write([]byte(`{"message": "Tag created successfully"}`))
+	})
+	mux.HandleFunc("/tag/info", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string][]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"team-search"}, body["names"])
+		if stored == nil {
+			w.Write([]byte(`{}`))
+			return
+		}
+		// The proxy returns the budget settings in the budget attached to the tag
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"team-search": map[string]interface{}{
+				"name":        stored["name"],
+				"description": stored["description"],
+				"models":      stored["models"],
+				"litellm_budget_table": map[string]interface{}{
+					"max_budget":      stored["max_budget"],
+					"budget_duration": stored["budget_duration"],
+					"tpm_limit":       stored["tpm_limit"],
+					"rpm_limit":       stored["rpm_limit"],
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/tag/update", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/tag/delete", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "team-search", body["name"])
+		stored = nil
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_tag"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("name", "team-search")
+	resourceData.Set("models", []interface{}{"gpt-4o-eu", "gpt-4o-us"})
+	resourceData.Set("max_budget", 200.0)
+	resourceData.Set("budget_duration", "30d")
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "team-search", resourceData.Id())
+	assert.Equal(t, []interface{}{"gpt-4o-eu", "gpt-4o-us"}, resourceData.Get("models"))
+	assert.Equal(t, 200.0, resourceData.Get("max_budget"))
+	assert.NotContains(t, stored, "tpm_limit")
+
+	// Test Read detects drift
+	stored["models"] = []interface{}{"gpt-4o-eu"}
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{"gpt-4o-eu"}, resourceData.Get("models"))
+
+	// Test Update
+	resourceData.Set("description", "Routes the search team to dedicated deployments")
+	resourceData.Set("rpm_limit", 100)
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "Routes the search team to dedicated deployments", stored["description"])
+	assert.Equal(t, 100, resourceData.Get("rpm_limit"))
+
+	// Test Update clears the description and budget removed from the configuration
+	resourceData = planUpdate(t, resource, resourceData, map[string]interface{}{
+		"name":   "team-search",
+		"models": []interface{}{"gpt-4o-eu"},
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	for _, name := range []string{"description", "max_budget", "budget_duration", "rpm_limit"} {
+		assert.Contains(t, stored, name)
+		assert.Nil(t, stored[name], name)
+	}
+	assert.Equal(t, "", resourceData.Get("description"))
+	assert.Equal(t, 0.0, resourceData.Get("max_budget"))
+	assert.Equal(t, 0, resourceData.Get("rpm_limit"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a tag deleted outside of Terraform
+	resourceData.SetId("team-search")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
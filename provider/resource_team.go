@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func resourceTeam() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamCreate,
+		ReadContext:   resourceTeamRead,
+		UpdateContext: resourceTeamUpdate,
+		DeleteContext: resourceTeamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The team_id of the team.",
+			},
+			"team_alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "User-friendly name of the team.",
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Model names the team can call. An empty list gives access to every model.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum spend in USD for the team.",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Period after which the spend of the team is reset, e.g. `30s`, `30m`, `30h`, `30d`.",
+			},
+			"tpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Tokens per minute limit of the team.",
+			},
+			"rpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Requests per minute limit of the team.",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Metadata attached to the team.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"blocked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the team is blocked. Requests made with the keys of a blocked team are rejected.",
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Organization the team belongs to.",
+			},
+		},
+	}
+}
+
+func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	team, err := client.CreateTeam(ctx, expandTeam(d))
+	if err != nil {
+		return diagFromErr(err, resourceTeam().Schema)
+	}
+
+	d.SetId(team.TeamID)
+
+	return resourceTeamRead(ctx, d, m)
+}
+
+func resourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	team, err := client.GetTeam(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The team was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceTeam().Schema)
+	}
+
+	if err := setTeamState(d, team); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	team := expandTeam(d)
+	team.TeamID = d.Id()
+
+	cleared := clearedAttributes(d, "max_budget", "budget_duration", "tpm_limit", "rpm_limit", "metadata", "organization_id")
+	if err := client.UpdateTeam(ctx, team, cleared...); err != nil {
+		return diagFromErr(err, resourceTeam().Schema)
+	}
+
+	return resourceTeamRead(ctx, d, m)
+}
+
+func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteTeam(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceTeam().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandTeam builds the API representation of the team from the resource configuration
+func expandTeam(d *schema.ResourceData) *litellmapi.Team {
+	return &litellmapi.Team{
+		TeamAlias:      d.Get("team_alias").(string),
+		Models:         expandStringList(d.Get("models").([]interface{})),
+		MaxBudget:      optionalFloat(d, "max_budget"),
+		BudgetDuration: d.Get("budget_duration").(string),
+		TpmLimit:       optionalInt(d, "tpm_limit"),
+		RpmLimit:       optionalInt(d, "rpm_limit"),
+		Metadata:       d.Get("metadata").(map[string]interface{}),
+		Blocked:        d.Get("blocked").(bool),
+		OrganizationID: d.Get("organization_id").(string),
+	}
+}
+
+// setTeamState refreshes the team attributes from the team returned by the API
+func setTeamState(d *schema.ResourceData, team *litellmapi.Team) error {
+	attributes := map[string]interface{}{
+		"team_alias":      team.TeamAlias,
+		"models":          team.Models,
+		"max_budget":      derefFloat(team.MaxBudget),
+		"budget_duration": team.BudgetDuration,
+		"tpm_limit":       derefInt(team.TpmLimit),
+		"rpm_limit":       derefInt(team.RpmLimit),
+		"metadata":        flattenStringMap(team.Metadata, d.Get("metadata").(map[string]interface{})),
+		"blocked":         team.Blocked,
+		"organization_id": team.OrganizationID,
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func resourceTeamMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamMemberCreate,
+		ReadContext:   resourceTeamMemberRead,
+		UpdateContext: resourceTeamMemberUpdate,
+		DeleteContext: resourceTeamMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTeamMemberImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the membership, in the form `team_id/user_id:<user_id>` or `team_id/user_email:<user_email>`.",
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Team the user is a member of.",
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"user_id", "user_email"},
+				Description:  "ID of the user. Exactly one of `user_id` and `user_email` must be set.",
+			},
+			"user_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"user_id", "user_email"},
+				Description:  "Email of the user. Exactly one of `user_id` and `user_email` must be set.",
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "user",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"admin", "user"}, false)),
+				Description:      "Role of the user in the team, either `admin` or `user`.",
+			},
+			"max_budget_in_team": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum spend in USD of the user within the team.",
+			},
+		},
+	}
+}
+
+func resourceTeamMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	teamID := d.Get("team_id").(string)
+	member := expandTeamMember(d)
+
+	if err := client.AddTeamMember(ctx, teamID, member); err != nil {
+		return diagFromErr(err, resourceTeamMember().Schema)
+	}
+
+	d.SetId(memberID(teamID, member.UserID, member.UserEmail))
+
+	return resourceTeamMemberRead(ctx, d, m)
+}
+
+func resourceTeamMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	teamID, userID, userEmail, err := parseMemberID(d.Id(), "team_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := client.GetTeamMember(ctx, teamID, userID, userEmail)
+	if litellmapi.IsNotFound(err) {
+		// The member was removed outside of Terraform, drop it from the state so it gets added back
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceTeamMember().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"team_id":            teamID,
+		"user_id":            member.UserID,
+		"user_email":         member.UserEmail,
+		"role":               member.Role,
+		"max_budget_in_team": derefFloat(member.MaxBudgetInTeam),
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceTeamMemberUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	if err := client.UpdateTeamMember(ctx, d.Get("team_id").(string), expandTeamMember(d)); err != nil {
+		return diagFromErr(err, resourceTeamMember().Schema)
+	}
+
+	return resourceTeamMemberRead(ctx, d, m)
+}
+
+func resourceTeamMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	err := client.RemoveTeamMember(ctx, d.Get("team_id").(string), expandTeamMember(d))
+	if err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceTeamMember().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceTeamMemberImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := parseMemberID(d.Id(), "team_id"); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// memberID formats the ID of a membership. The kind of identifier is explicit, since user IDs are often
+// emails or SSO subjects containing an @ themselves.
+func memberID(parentID string, userID string, userEmail string) string {
+	if userID != "" {
+		return fmt.Sprintf("%s/user_id:%s", parentID, userID)
+	}
+	return fmt.Sprintf("%s/user_email:%s", parentID, userEmail)
+}
+
+// parseMemberID splits a parent_id/user_id:<user_id> or parent_id/user_email:<user_email> member ID
+func parseMemberID(id string, parent string) (parentID string, userID string, userEmail string, err error) {
+	parentID, identifier, found := strings.Cut(id, "/")
+	if !found || parentID == "" || identifier == "" {
+		return "", "", "", fmt.Errorf("invalid member ID %q, expected %s/user_id:<user_id> or %s/user_email:<user_email>", id, parent, parent)
+	}
+	if value, found := strings.CutPrefix(identifier, "user_id:"); found && value != "" {
+		return parentID, value, "", nil
+	}
+	if value, found := strings.CutPrefix(identifier, "user_email:"); found && value != "" {
+		return parentID, "", value, nil
+	}
+	return "", "", "", fmt.Errorf("invalid member ID %q, expected %s/user_id:<user_id> or %s/user_email:<user_email>", id, parent, parent)
+}
+
+// expandTeamMember builds the API representation of the member, preferring user_id when both identifiers are known
+func expandTeamMember(d *schema.ResourceData) *litellmapi.TeamMember {
+	member := &litellmapi.TeamMember{
+		UserID:          d.Get("user_id").(string),
+		Role:            d.Get("role").(string),
+		MaxBudgetInTeam: optionalFloat(d, "max_budget_in_team"),
+	}
+	if member.UserID == "" {
+		member.UserEmail = d.Get("user_email").(string)
+	}
+	return member
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceTeamMemberCRUD(t *testing.T) {
+	members := []map[string]interface{}{
+		{"user_id": "admin-user", "role": "admin"},
+	}
+	budgets := map[string]float64{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/team/member_add", func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			TeamID          string                 `json:"team_id"`
+			Member          map[string]interface{} `json:"member"`
+			MaxBudgetInTeam float64                `json:"max_budget_in_team"`
+		}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "team-id-1", body.TeamID)
+		assert.Equal(t, "jane@example.com", body.Member["user_email"])
+
+		body.Member["user_id"] = "jane-id"
+		members = append(members, body.Member)
+		budgets["jane-id"] = body.MaxBudgetInTeam
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/team/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "team-id-1", r.URL.Query().Get("team_id"))
+		var memberships []map[string]interface{}
+		for userID, budget := range budgets {
+			memberships = append(memberships, map[string]interface{}{
+				"user_id":              userID,
+				"team_id":              "team-id-1",
+				"litellm_budget_table": map[string]interface{}{"max_budget": budget},
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"team_id":          "team-id-1",
+			"team_info":        map[string]interface{}{"team_id": "team-id-1", "members_with_roles": members},
+			"team_memberships": memberships,
+		})
+	})
+	mux.HandleFunc("/team/member_update", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "jane-id", body["user_id"])
+		members[1]["role"] = body["role"]
+		budgets["jane-id"] = body["max_budget_in_team"].(float64)
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/team/member_delete", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "jane-id", body["user_id"])
+		members = members[:1]
+		delete(budgets, "jane-id")
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_team_member"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("team_id", "team-id-1")
+	resourceData.Set("user_email", "jane@example.com")
+	resourceData.Set("role", "user")
+	resourceData.Set("max_budget_in_team", 10.0)
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "team-id-1/user_email:jane@example.com", resourceData.Id())
+	assert.Equal(t, "jane-id", resourceData.Get("user_id"))
+	assert.Equal(t, 10.0, resourceData.Get("max_budget_in_team"))
+
+	// Test Update
+	resourceData.Set("role", "admin")
+	resourceData.Set("max_budget_in_team", 20.0)
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "admin", resourceData.Get("role"))
+	assert.Equal(t, 20.0, resourceData.Get("max_budget_in_team"))
+
+	// Test Read detects a member removed outside of Terraform
+	removed := members[1]
+	members = members[:1]
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Delete
+	members = append(members, removed)
+	resourceData.SetId("team-id-1/user_id:jane-id")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "jane@example.com", resourceData.Get("user_email"))
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Len(t, members, 1)
+}
+
+func TestParseMemberID(t *testing.T) {
+	teamID, userID, userEmail, err := parseMemberID("team-id-1/user_id:jane@example.com", "team_id")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"team-id-1", "jane@example.com", ""}, []string{teamID, userID, userEmail})
+
+	teamID, userID, userEmail, err = parseMemberID("team-id-1/user_email:jane@example.com", "team_id")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"team-id-1", "", "jane@example.com"}, []string{teamID, userID, userEmail})
+
+	// Test IDs without the kind of identifier are rejected
+	for _, id := range []string{"team-id-1", "team-id-1/jane-id", "team-id-1/jane@example.com", "team-id-1/user_id:"} {
+		_, _, _, err = parseMemberID(id, "team_id")
+		assert.Error(t, err, id)
+	}
+}
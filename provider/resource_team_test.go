@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceTeamCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/team/new", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		stored["team_id"] = "team-id-1"
+		stored["metadata"] = map[string]interface{}{"owner": "platform", "created_by": "admin"}
+		json.NewEncoder(w).Encode(stored)
+	})
+	mux.HandleFunc("/team/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "team-id-1", r.URL.Query().Get("team_id"))
+		if stored == nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": {"error": "Team not found, passed team_id=team-id-1"}}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"team_id": "team-id-1", "team_info": stored})
+	})
+	mux.HandleFunc("/team/update", func(w http.ResponseWriter, r *http.Request) {
+		update := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+		assert.Equal(t, "team-id-1", update["team_id"])
+		for k, v := range update {
+			stored[k] = v
+		}
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/team/delete", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string][]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"team-id-1"}, body["team_ids"])
+		stored = nil
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_team"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("team_alias", "platform")
+	resourceData.Set("models", []interface{}{"gpt-4o"})
+	resourceData.Set("max_budget", 500.0)
+	resourceData.Set("budget_duration", "30d")
+	resourceData.Set("metadata", map[string]interface{}{"owner": "platform"})
+	resourceData.Set("organization_id", "org-1")
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "team-id-1", resourceData.Id())
+	assert.Equal(t, false, stored["blocked"])
+	assert.Equal(t, map[string]interface{}{"owner": "platform"}, resourceData.Get("metadata"))
+	assert.Equal(t, "org-1", resourceData.Get("organization_id"))
+
+	// Test Read detects drift
+	stored["blocked"] = true
+	stored["models"] = []interface{}{"gpt-4o", "gpt-4o-mini"}
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, true, resourceData.Get("blocked"))
+	assert.Equal(t, []interface{}{"gpt-4o", "gpt-4o-mini"}, resourceData.Get("models"))
+
+	// Test Update
+	resourceData.Set("blocked", false)
+	resourceData.Set("tpm_limit", 5000)
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, false, stored["blocked"])
+	assert.Equal(t, float64(5000), stored["tpm_limit"])
+
+	// Test Update clears the budget removed from the configuration
+	updated := planUpdate(t, resource, resourceData, map[string]interface{}{
+		"team_alias":      "platform",
+		"models":          []interface{}{"gpt-4o", "gpt-4o-mini"},
+		"tpm_limit":       5000,
+		"metadata":        map[string]interface{}{"owner": "platform"},
+		"organization_id": "org-1",
+	})
+	diags = resource.UpdateContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, stored, "max_budget")
+	assert.Nil(t, stored["max_budget"])
+	assert.Nil(t, stored["budget_duration"])
+	assert.Equal(t, 0.0, updated.Get("max_budget"))
+	assert.Equal(t, "", updated.Get("budget_duration"))
+
+	// Test Update clears the metadata and organization removed from the configuration
+	updated = planUpdate(t, resource, updated, map[string]interface{}{
+		"team_alias": "platform",
+		"models":     []interface{}{"gpt-4o", "gpt-4o-mini"},
+		"tpm_limit":  5000,
+	})
+	diags = resource.UpdateContext(context.Background(), updated, meta)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, stored, "metadata")
+	assert.Nil(t, stored["metadata"])
+	assert.Contains(t, stored, "organization_id")
+	assert.Nil(t, stored["organization_id"])
+	assert.Empty(t, updated.Get("metadata"))
+	assert.Equal(t, "", updated.Get("organization_id"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a team deleted outside of Terraform
+	resourceData.SetId("team-id-1")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
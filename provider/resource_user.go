@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+var userRoles = []string{"proxy_admin", "proxy_admin_viewer", "internal_user", "internal_user_viewer"}
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user_id of the user.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the user. Generated by the proxy when omitted.",
+			},
+			"user_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Email of the user.",
+			},
+			"user_role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "internal_user",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(userRoles, false)),
+				Description:      "Role of the user, one of `proxy_admin`, `proxy_admin_viewer`, `internal_user` or `internal_user_viewer`.",
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Model names the user can call. An empty list gives access to every model.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum spend in USD for the user.",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Period after which the spend of the user is reset, e.g. `30s`, `30m`, `30h`, `30d`.",
+			},
+			"tpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Tokens per minute limit of the user.",
+			},
+			"rpm_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Requests per minute limit of the user.",
+			},
+			"teams": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of teams the user is added to, with the `user` role. Only the listed teams are managed, memberships added by `litellm_team_member` or outside of Terraform are left alone. Use `litellm_team_member` instead to manage roles and budgets within teams.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Metadata attached to the user.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"auto_create_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the proxy generates a key for the user when it is created. Set to `false` to manage the keys of the user with `litellm_key`. Changing it after creation has no effect.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key generated for the user when `auto_create_key` is set. Only known when the user is created by Terraform.",
+			},
+		},
+	}
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	user := expandUser(d)
+	user.UserID = d.Get("user_id").(string)
+	user.Teams = expandStringSet(d.Get("teams").(*schema.Set))
+
+	created, err := client.CreateUser(ctx, user, d.Get("auto_create_key").(bool))
+	if err != nil {
+		return diagFromErr(err, resourceUser().Schema)
+	}
+
+	d.SetId(created.UserID)
+	if err := d.Set("key", created.Key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceUserRead(ctx, d, m)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	user, err := client.GetUser(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The user was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceUser().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"user_id":         d.Id(),
+		"user_email":      user.UserEmail,
+		"user_role":       user.UserRole,
+		"models":          user.Models,
+		"max_budget":      derefFloat(user.MaxBudget),
+		"budget_duration": user.BudgetDuration,
+		"tpm_limit":       derefInt(user.TpmLimit),
+		"rpm_limit":       derefInt(user.RpmLimit),
+		"teams":           flattenUserTeams(user.Teams, d.Get("teams").(*schema.Set)),
+		"metadata":        flattenStringMap(user.Metadata, d.Get("metadata").(map[string]interface{})),
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	user := expandUser(d)
+	user.UserID = d.Id()
+
+	cleared := clearedAttributes(d, "user_email", "max_budget", "budget_duration", "tpm_limit", "rpm_limit", "metadata")
+	if err := client.UpdateUser(ctx, user, cleared...); err != nil {
+		return diagFromErr(err, resourceUser().Schema)
+	}
+
+	// /user/update does not change team membership, apply it through the team member endpoints
+	if d.HasChange("teams") {
+		oldTeams, newTeams := d.GetChange("teams")
+		member := &litellmapi.TeamMember{UserID: d.Id(), Role: "user"}
+
+		for _, teamID := range expandStringSet(newTeams.(*schema.Set).Difference(oldTeams.(*schema.Set))) {
+			if err := client.AddTeamMember(ctx, teamID, member); err != nil {
+				return diagFromErr(err, resourceUser().Schema)
+			}
+		}
+		for _, teamID := range expandStringSet(oldTeams.(*schema.Set).Difference(newTeams.(*schema.Set))) {
+			if err := client.RemoveTeamMember(ctx, teamID, member); err != nil && !litellmapi.IsNotFound(err) {
+				return diagFromErr(err, resourceUser().Schema)
+			}
+		}
+	}
+
+	return resourceUserRead(ctx, d, m)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteUser(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceUser().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceUserImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	// auto_create_key only matters at creation, assume the default so that importing does not plan a change
+	if err := d.Set("auto_create_key", true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandUser builds the API representation of the user from the resource configuration
+func expandUser(d *schema.ResourceData) *litellmapi.User {
+	return &litellmapi.User{
+		UserEmail:      d.Get("user_email").(string),
+		UserRole:       d.Get("user_role").(string),
+		Models:         expandStringList(d.Get("models").([]interface{})),
+		MaxBudget:      optionalFloat(d, "max_budget"),
+		BudgetDuration: d.Get("budget_duration").(string),
+		TpmLimit:       optionalInt(d, "tpm_limit"),
+		RpmLimit:       optionalInt(d, "rpm_limit"),
+		Metadata:       d.Get("metadata").(map[string]interface{}),
+	}
+}
+
+// flattenUserTeams keeps the teams of the user that are managed by this resource, so that memberships
+// managed by litellm_team_member never end up in the state and get removed by a later update.
+func flattenUserTeams(teams []string, current *schema.Set) []string {
+	managed := make([]string, 0, len(teams))
+	for _, team := range teams {
+		if current.Contains(team) {
+			managed = append(managed, team)
+		}
+	}
+	return managed
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceUserCRUD(t *testing.T) {
+	var stored map[string]interface{}
+	var teamChanges []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user/new", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		assert.Equal(t, false, stored["auto_create_key"])
+		delete(stored, "auto_create_key")
+		stored["user_id"] = "user-id-1"
+
+		json.NewEncoder(w).Encode(stored)
+	})
+	mux.HandleFunc("/user/info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "user-id-1", r.URL.Query().Get("user_id"))
+		json.NewEncoder(w).Encode(map[string]interface{}{"user_id": "user-id-1", "user_info": stored})
+	})
+	mux.HandleFunc("/user/update", func(w http.ResponseWriter, r *http.Request) {
+		update := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&update))
+		assert.Equal(t, "user-id-1", update["user_id"])
+		assert.NotContains(t, update, "teams")
+		for k, v := range update {
+			stored[k] = v
+		}
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/team/member_add", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		teamChanges = append(teamChanges, "add "+body["team_id"].(string))
+		stored["teams"] = append(stored["teams"].([]interface{}), body["team_id"])
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/team/member_delete", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		teamChanges = append(teamChanges, "delete "+body["team_id"].(string))
+		var teams []interface{}
+		for _, team := range stored["teams"].([]interface{}) {
+			if team != body["team_id"] {
+				teams = append(teams, team)
+			}
+		}
+		stored["teams"] = teams
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/user/delete", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string][]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"user-id-1"}, body["user_ids"])
+		stored = nil
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_user"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("user_email", "jane@example.com")
+	resourceData.Set("user_role", "internal_user_viewer")
+	resourceData.Set("teams", []interface{}{"team-a"})
+	resourceData.Set("max_budget", 25.0)
+	resourceData.Set("auto_create_key", false)
+	resourceData.Set("metadata", map[string]interface{}{"owner": "platform"})
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "user-id-1", resourceData.Id())
+	assert.Equal(t, "user-id-1", resourceData.Get("user_id"))
+	assert.Equal(t, "", resourceData.Get("key"))
+	assert.Equal(t, 25.0, resourceData.Get("max_budget"))
+
+	// Test Update applies team membership changes through the team endpoints
+	resourceData = planUpdate(t, resource, resourceData, map[string]interface{}{
+		"user_email":      "jane@example.com",
+		"user_role":       "internal_user",
+		"teams":           []interface{}{"team-b"},
+		"max_budget":      25.0,
+		"auto_create_key": false,
+		"metadata":        map[string]interface{}{"owner": "platform"},
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"add team-b", "delete team-a"}, teamChanges)
+	assert.Equal(t, "internal_user", stored["user_role"])
+	assert.Equal(t, []interface{}{"team-b"}, resourceData.Get("teams").(interface{ List() []interface{} }).List())
+
+	// Test Read ignores memberships managed by litellm_team_member
+	stored["teams"] = append(stored["teams"].([]interface{}), "team-c")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{"team-b"}, resourceData.Get("teams").(interface{ List() []interface{} }).List())
+
+	// Test Update leaves memberships outside of teams alone
+	teamChanges = nil
+	resourceData = planUpdate(t, resource, resourceData, map[string]interface{}{
+		"user_email":      "jane@example.com",
+		"user_role":       "internal_user",
+		"teams":           []interface{}{"team-b", "team-d"},
+		"max_budget":      25.0,
+		"auto_create_key": false,
+		"metadata":        map[string]interface{}{"owner": "platform"},
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"add team-d"}, teamChanges)
+	assert.Contains(t, stored["teams"], "team-c")
+
+	// Test removing every managed team keeps the membership owned by litellm_team_member
+	teamChanges = nil
+	resourceData = planUpdate(t, resource, resourceData, map[string]interface{}{
+		"user_email":      "jane@example.com",
+		"user_role":       "internal_user",
+		"teams":           []interface{}{},
+		"max_budget":      25.0,
+		"auto_create_key": false,
+		"metadata":        map[string]interface{}{"owner": "platform"},
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.ElementsMatch(t, []string{"delete team-b", "delete team-d"}, teamChanges)
+	assert.Equal(t, []interface{}{"team-c"}, stored["teams"])
+	assert.Equal(t, 0, resourceData.Get("teams").(interface{ Len() int }).Len())
+
+	// Test the next apply leaves that membership alone
+	teamChanges = nil
+	resourceData = planUpdate(t, resource, resourceData, map[string]interface{}{
+		"user_email":      "jane@example.com",
+		"user_role":       "internal_user",
+		"max_budget":      25.0,
+		"auto_create_key": false,
+		"metadata":        map[string]interface{}{"owner": "platform"},
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Empty(t, teamChanges)
+	assert.Equal(t, []interface{}{"team-c"}, stored["teams"])
+
+	// Test Update clears the budget and metadata removed from the configuration
+	resourceData = planUpdate(t, resource, resourceData, map[string]interface{}{
+		"user_email":      "jane@example.com",
+		"user_role":       "internal_user",
+		"teams":           []interface{}{"team-b", "team-d"},
+		"auto_create_key": false,
+	})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, stored, "max_budget")
+	assert.Nil(t, stored["max_budget"])
+	assert.Contains(t, stored, "metadata")
+	assert.Nil(t, stored["metadata"])
+	assert.Equal(t, 0.0, resourceData.Get("max_budget"))
+	assert.Empty(t, resourceData.Get("metadata"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a user deleted outside of Terraform
+	resourceData.SetId("user-id-1")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gzamboni/terraform-provider-litellm/provider/litellmapi"
+)
+
+func resourceVectorStore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVectorStoreCreate,
+		ReadContext:   resourceVectorStoreRead,
+		UpdateContext: resourceVectorStoreUpdate,
+		DeleteContext: resourceVectorStoreDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The vector_store_id of the vector store.",
+			},
+			"vector_store_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the vector store at the provider, e.g. the ID of a Bedrock knowledge base.",
+			},
+			"custom_llm_provider": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Provider hosting the vector store, e.g. `bedrock`, `openai` or `vertex_ai`.",
+			},
+			"vector_store_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the vector store.",
+			},
+			"vector_store_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the vector store.",
+			},
+			"vector_store_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Metadata attached to the vector store.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"litellm_credential_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a `litellm_credential` used to access the vector store.",
+			},
+		},
+	}
+}
+
+func resourceVectorStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	vectorStore := expandVectorStore(d)
+	if err := client.CreateVectorStore(ctx, vectorStore); err != nil {
+		return diagFromErr(err, resourceVectorStore().Schema)
+	}
+
+	d.SetId(vectorStore.VectorStoreID)
+
+	return resourceVectorStoreRead(ctx, d, m)
+}
+
+func resourceVectorStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	vectorStore, err := client.GetVectorStore(ctx, d.Id())
+	if litellmapi.IsNotFound(err) {
+		// The vector store was deleted outside of Terraform, drop it from the state so it gets recreated
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diagFromErr(err, resourceVectorStore().Schema)
+	}
+
+	attributes := map[string]interface{}{
+		"vector_store_id":          d.Id(),
+		"custom_llm_provider":      vectorStore.CustomLlmProvider,
+		"vector_store_name":        vectorStore.VectorStoreName,
+		"vector_store_description": vectorStore.VectorStoreDescription,
+		"vector_store_metadata":    flattenStringMap(vectorStore.VectorStoreMetadata, d.Get("vector_store_metadata").(map[string]interface{})),
+		"litellm_credential_name":  vectorStore.LitellmCredentialName,
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceVectorStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	if err := client.UpdateVectorStore(ctx, expandVectorStore(d)); err != nil {
+		return diagFromErr(err, resourceVectorStore().Schema)
+	}
+
+	return resourceVectorStoreRead(ctx, d, m)
+}
+
+func resourceVectorStoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*litellmapi.LitellmClient)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteVectorStore(ctx, d.Id()); err != nil && !litellmapi.IsNotFound(err) {
+		return diagFromErr(err, resourceVectorStore().Schema)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// expandVectorStore builds the API representation of the vector store from the resource configuration
+func expandVectorStore(d *schema.ResourceData) *litellmapi.VectorStore {
+	return &litellmapi.VectorStore{
+		VectorStoreID:          d.Get("vector_store_id").(string),
+		CustomLlmProvider:      d.Get("custom_llm_provider").(string),
+		VectorStoreName:        d.Get("vector_store_name").(string),
+		VectorStoreDescription: d.Get("vector_store_description").(string),
+		VectorStoreMetadata:    d.Get("vector_store_metadata").(map[string]interface{}),
+		LitellmCredentialName:  d.Get("litellm_credential_name").(string),
+	}
+}
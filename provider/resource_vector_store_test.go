@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceVectorStoreCRUD(t *testing.T) {
+	var stored map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/vector_store/new", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		w.Write([]byte(`{"status": "success"}`))
+	})
+	mux.HandleFunc("/vector_store/info", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "T37J8R4WTM", body["vector_store_id"])
+		if stored == nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Vector store with ID T37J8R4WTM not found"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"vector_store": stored})
+	})
+	mux.HandleFunc("/vector_store/update", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&stored))
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/vector_store/delete", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "T37J8R4WTM", body["vector_store_id"])
+		stored = nil
+		w.Write([]byte(`{}`))
+	})
+
+	p, meta := configureTestProvider(t, mux)
+	resource := p.ResourcesMap["litellm_vector_store"]
+
+	resourceData := resource.TestResourceData()
+	resourceData.Set("vector_store_id", "T37J8R4WTM")
+	resourceData.Set("custom_llm_provider", "bedrock")
+	resourceData.Set("vector_store_name", "product-docs")
+	resourceData.Set("litellm_credential_name", "aws-prod")
+
+	// Test Create
+	diags := resource.CreateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "T37J8R4WTM", resourceData.Id())
+	assert.Equal(t, "aws-prod", stored["litellm_credential_name"])
+	assert.NotContains(t, stored, "vector_store_metadata")
+
+	// Test Read detects drift
+	stored["vector_store_description"] = "Changed in the UI"
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "Changed in the UI", resourceData.Get("vector_store_description"))
+
+	// Test Update
+	resourceData.Set("vector_store_metadata", map[string]interface{}{"owner": "search"})
+	diags = resource.UpdateContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, map[string]interface{}{"owner": "search"}, stored["vector_store_metadata"])
+	assert.Equal(t, map[string]interface{}{"owner": "search"}, resourceData.Get("vector_store_metadata"))
+
+	// Test Delete
+	diags = resource.DeleteContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+
+	// Test Read removes a vector store deleted outside of Terraform
+	resourceData.SetId("T37J8R4WTM")
+	diags = resource.ReadContext(context.Background(), resourceData, meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+}
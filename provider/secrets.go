@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// envReferencePattern matches the os.environ/VAR references LiteLLM resolves from its own environment
+var envReferencePattern = regexp.MustCompile(`^os\.environ/[A-Za-z_][A-Za-z0-9_]*$`)
+
+// validateSecretReference rejects malformed environment references such as os.environ.VAR or os.environ/,
+// which the proxy would otherwise send as is to the provider. Plain secrets are accepted.
+func validateSecretReference(value string) error {
+	if strings.HasPrefix(value, "os.environ") && !envReferencePattern.MatchString(value) {
+		return fmt.Errorf("%q is not a valid environment variable reference, expected os.environ/VARIABLE_NAME", value)
+	}
+	return nil
+}
+
+// validateSecret is the ValidateDiagFunc of secret string attributes
+func validateSecret(v interface{}, path cty.Path) diag.Diagnostics {
+	if err := validateSecretReference(v.(string)); err != nil {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: err.Error(), AttributePath: path}}
+	}
+	return nil
+}
+
+// validateSecretMap is the ValidateDiagFunc of secret map attributes
+func validateSecretMap(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for key, value := range v.(map[string]interface{}) {
+		if err := validateSecretReference(fmt.Sprintf("%v", value)); err != nil {
+			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: err.Error(), AttributePath: path.IndexString(key)})
+		}
+	}
+	return diags
+}
+
+// validateSecretJSON is the ValidateDiagFunc of write-only attributes holding a JSON object of secrets
+func validateSecretJSON(v interface{}, path cty.Path) diag.Diagnostics {
+	secrets, err := parseSecretJSON(v.(string))
+	if err != nil {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: err.Error(), AttributePath: path}}
+	}
+	return validateSecretMap(secrets, path)
+}
+
+func parseSecretJSON(value string) (map[string]interface{}, error) {
+	secrets := map[string]interface{}{}
+	if value == "" {
+		return secrets, nil
+	}
+	if err := json.Unmarshal([]byte(value), &secrets); err != nil {
+		return nil, fmt.Errorf("expected a JSON object: %w", err)
+	}
+	return secrets, nil
+}
+
+// writeOnlyString returns the configured value of a write-only attribute. Write-only values are never
+// in the state, they are only available from the configuration while the resource is created or updated.
+func writeOnlyString(d *schema.ResourceData, path cty.Path) string {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return ""
+	}
+	value, err := path.Apply(config)
+	if err != nil || value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return ""
+	}
+	return value.AsString()
+}
+
+// writeOnlySecrets returns the JSON object of secrets configured in a write-only attribute
+func writeOnlySecrets(d *schema.ResourceData, path cty.Path) (map[string]interface{}, error) {
+	secrets, err := parseSecretJSON(writeOnlyString(d, path))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", formatPath(path), err)
+	}
+	return secrets, nil
+}
+
+// formatPath renders a path the way attributes are written in the configuration, e.g. litellm_params.0.api_key_wo
+func formatPath(path cty.Path) string {
+	parts := make([]string, 0, len(path))
+	for _, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, step.Name)
+		case cty.IndexStep:
+			if step.Key.Type().Equals(cty.String) {
+				parts = append(parts, step.Key.AsString())
+			} else {
+				parts = append(parts, step.Key.AsBigFloat().String())
+			}
+		}
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSecret(t *testing.T) {
+	path := cty.GetAttrPath("api_key")
+
+	assert.Empty(t, validateSecret("sk-plain-secret", path))
+	assert.Empty(t, validateSecret("os.environ/OPENAI_API_KEY", path))
+
+	for _, value := range []string{"os.environ/", "os.environ.OPENAI_API_KEY", "os.environ/OPENAI-API-KEY", "os.environ/1_KEY", "os.environ/KEY/extra"} {
+		diags := validateSecret(value, path)
+		assert.True(t, diags.HasError(), value)
+		assert.Equal(t, path, diags[0].AttributePath)
+	}
+
+	diags := validateSecretMap(map[string]interface{}{"api_key": "os.environ/AZURE_KEY", "api_base": "os.environ.AZURE_BASE"}, cty.GetAttrPath("credential_values"))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, cty.GetAttrPath("credential_values").IndexString("api_base"), diags[0].AttributePath)
+
+	assert.Empty(t, validateSecretJSON(`{"api_key": "os.environ/AZURE_KEY"}`, path))
+	assert.True(t, validateSecretJSON(`{"api_key": "os.environ/"}`, path).HasError())
+	assert.True(t, validateSecretJSON(`["os.environ/AZURE_KEY"]`, path).HasError())
+}
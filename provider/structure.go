@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// flattenStringMap converts the values returned by the API into the string map stored in the state.
+// When the state already holds values, only those keys are refreshed: the proxy adds many defaulted
+// keys of its own and strips secrets such as api_key, which would otherwise show up as perpetual drift.
+// Keys the proxy does not send back keep the value from the state.
+func flattenStringMap(remote map[string]interface{}, current map[string]interface{}) map[string]string {
+	result := make(map[string]string)
+
+	if len(current) == 0 {
+		for k, v := range remote {
+			if value, ok := flattenValue(v); ok {
+				result[k] = value
+			}
+		}
+		return result
+	}
+
+	for k, v := range current {
+		result[k] = fmt.Sprintf("%v", v)
+		if remoteValue, found := remote[k]; found {
+			if value, ok := flattenValue(remoteValue); ok {
+				result[k] = value
+			}
+		}
+	}
+	return result
+}
+
+// flattenValue renders a scalar JSON value as a string, nested values and nulls are skipped
+func flattenValue(v interface{}) (string, bool) {
+	switch value := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return value, true
+	case bool:
+		return strconv.FormatBool(value), true
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+// clearedAttributes returns the attributes among names that were removed from the configuration. The proxy
+// keeps the value of the fields missing from an update, so these are sent as null to clear them.
+func clearedAttributes(d *schema.ResourceData, names ...string) []string {
+	var cleared []string
+	for _, name := range names {
+		if d.HasChange(name) && isUnset(d, name) {
+			cleared = append(cleared, name)
+		}
+	}
+	return cleared
+}
+
+// isUnset reports whether the top level attribute is absent from the configuration. Blocks are never null,
+// they are unset when none is configured. Without a raw configuration, as in unit tests, the attribute is
+// unset when it holds its zero value.
+func isUnset(d *schema.ResourceData, name string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		_, ok := d.GetOk(name)
+		return !ok
+	}
+	value := config.GetAttr(name)
+	if value.IsNull() {
+		return true
+	}
+	return value.IsKnown() && value.Type().IsCollectionType() && value.LengthInt() == 0
+}
+
+func expandStringList(list []interface{}) []string {
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// optionalFloat returns a pointer to the attribute value, or nil when it is not set so that it is omitted from the request
+func optionalFloat(d *schema.ResourceData, key string) *float64 {
+	if v, ok := d.GetOk(key); ok {
+		value := v.(float64)
+		return &value
+	}
+	return nil
+}
+
+// optionalInt returns a pointer to the attribute value, or nil when it is not set so that it is omitted from the request
+func optionalInt(d *schema.ResourceData, key string) *int64 {
+	if v, ok := d.GetOk(key); ok {
+		value := int64(v.(int))
+		return &value
+	}
+	return nil
+}
+
+func derefFloat(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func derefInt(v *int64) int {
+	if v == nil {
+		return 0
+	}
+	return int(*v)
+}
+
+func expandStringSet(set *schema.Set) []string {
+	return expandStringList(set.List())
+}
+
+// nonZeroFloat is optionalFloat for values nested in blocks, where unset attributes read as zero
+func nonZeroFloat(v float64) *float64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+// nonZeroInt is optionalInt for values nested in blocks, where unset attributes read as zero
+func nonZeroInt(v int) *int64 {
+	if v == 0 {
+		return nil
+	}
+	value := int64(v)
+	return &value
+}
+
+// convertValue converts a JSON value returned by the API to the type of a schema attribute. Values sent as
+// strings by earlier versions of the provider, such as "10" for rpm, are parsed. Anything else reads as zero.
+func convertValue(v interface{}, valueType schema.ValueType) interface{} {
+	s, isString := v.(string)
+	switch valueType {
+	case schema.TypeInt:
+		if f, ok := v.(float64); ok {
+			return int(f)
+		}
+		if i, err := strconv.Atoi(s); isString && err == nil {
+			return i
+		}
+		return 0
+	case schema.TypeFloat:
+		if f, ok := v.(float64); ok {
+			return f
+		}
+		if f, err := strconv.ParseFloat(s, 64); isString && err == nil {
+			return f
+		}
+		return 0.0
+	case schema.TypeBool:
+		if b, ok := v.(bool); ok {
+			return b
+		}
+		if b, err := strconv.ParseBool(s); isString && err == nil {
+			return b
+		}
+		return false
+	default:
+		value, _ := flattenValue(v)
+		return value
+	}
+}
+
+// normalizeJSON is the StateFunc of JSON attributes, so that formatting and key order do not show up as a diff
+func normalizeJSON(v interface{}) string {
+	normalized, err := structure.NormalizeJsonString(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return normalized
+}